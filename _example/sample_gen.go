@@ -4,7 +4,6 @@ import (
 	"github.com/gopherjs/vecty"
 	"github.com/gopherjs/vecty/elem"
 	"github.com/gopherjs/vecty/event"
-	"github.com/gopherjs/vecty/prop"
 )
 
 // NewSample ...
@@ -23,9 +22,6 @@ type Sample struct {
 // Render ...
 func (c *Sample) Render() vecty.ComponentOrHTML {
 	return elem.Body(
-		vecty.Markup(
-			vecty.Property("", "true"),
-		),
 		elem.Input(
 			vecty.Markup(
 				vecty.ClassMap{
@@ -35,7 +31,7 @@ func (c *Sample) Render() vecty.ComponentOrHTML {
 					"boo4": true,
 					"boo5": true,
 				},
-				prop.Disabled(true),
+				vecty.Property("disabled", true),
 			),
 			vecty.Text("Hello"),
 			elem.Break(
@@ -54,7 +50,7 @@ func (c *Sample) Render() vecty.ComponentOrHTML {
 	)
 }
 
-// Click ...
+// Click handles @click on <button>.
 func (c *Sample) Click(event *vecty.Event) {
 	f, ok := c.dispatcher["Click"]
 	if !ok {
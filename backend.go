@@ -0,0 +1,238 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strings"
+)
+
+// goApp is the import path of the go-app backend.
+const goApp = "github.com/maxence-charriere/go-app/v9/pkg/app"
+
+// goAppElems maps the html tags to their go-app constructor, the other tags
+// are created by app.Elem.
+var goAppElems = map[string]string{
+	"a":          "A",
+	"abbr":       "Abbr",
+	"address":    "Address",
+	"area":       "Area",
+	"article":    "Article",
+	"aside":      "Aside",
+	"audio":      "Audio",
+	"b":          "B",
+	"base":       "Base",
+	"bdi":        "Bdi",
+	"bdo":        "Bdo",
+	"blockquote": "Blockquote",
+	"body":       "Body",
+	"br":         "Br",
+	"button":     "Button",
+	"canvas":     "Canvas",
+	"caption":    "Caption",
+	"cite":       "Cite",
+	"code":       "Code",
+	"col":        "Col",
+	"colgroup":   "ColGroup",
+	"data":       "Data",
+	"datalist":   "DataList",
+	"dd":         "Dd",
+	"del":        "Del",
+	"details":    "Details",
+	"dfn":        "Dfn",
+	"dialog":     "Dialog",
+	"div":        "Div",
+	"dl":         "Dl",
+	"dt":         "Dt",
+	"em":         "Em",
+	"embed":      "Embed",
+	"fieldset":   "FieldSet",
+	"figcaption": "FigCaption",
+	"figure":     "Figure",
+	"footer":     "Footer",
+	"form":       "Form",
+	"h1":         "H1",
+	"h2":         "H2",
+	"h3":         "H3",
+	"h4":         "H4",
+	"h5":         "H5",
+	"h6":         "H6",
+	"head":       "Head",
+	"header":     "Header",
+	"hr":         "Hr",
+	"html":       "Html",
+	"i":          "I",
+	"iframe":     "IFrame",
+	"img":        "Img",
+	"input":      "Input",
+	"ins":        "Ins",
+	"kbd":        "Kbd",
+	"label":      "Label",
+	"legend":     "Legend",
+	"li":         "Li",
+	"link":       "Link",
+	"main":       "Main",
+	"map":        "Map",
+	"mark":       "Mark",
+	"meta":       "Meta",
+	"meter":      "Meter",
+	"nav":        "Nav",
+	"noscript":   "NoScript",
+	"object":     "Object",
+	"ol":         "Ol",
+	"optgroup":   "OptGroup",
+	"option":     "Option",
+	"output":     "Output",
+	"p":          "P",
+	"param":      "Param",
+	"picture":    "Picture",
+	"pre":        "Pre",
+	"progress":   "Progress",
+	"q":          "Q",
+	"rp":         "Rp",
+	"rt":         "Rt",
+	"ruby":       "Ruby",
+	"s":          "S",
+	"samp":       "Samp",
+	"script":     "Script",
+	"section":    "Section",
+	"select":     "Select",
+	"small":      "Small",
+	"source":     "Source",
+	"span":       "Span",
+	"strong":     "Strong",
+	"style":      "Style",
+	"sub":        "Sub",
+	"summary":    "Summary",
+	"sup":        "Sup",
+	"table":      "Table",
+	"tbody":      "TBody",
+	"td":         "Td",
+	"template":   "Template",
+	"textarea":   "Textarea",
+	"tfoot":      "TFoot",
+	"th":         "Th",
+	"thead":      "THead",
+	"time":       "Time",
+	"title":      "Title",
+	"tr":         "Tr",
+	"u":          "U",
+	"ul":         "Ul",
+	"var":        "Var",
+	"video":      "Video",
+	"wbr":        "Wbr",
+}
+
+// Backend emits the go code of the parsed tree for a ui package.
+type Backend interface {
+	// Text returns the expression of a text node.
+	Text(c *Converter, text string) string
+	// Element returns the expression of an element, body holds its children
+	// already written as "\n<tab>expr," at indent+1.
+	Element(c *Converter, tag string, attrSlice []attr, body string, indent int) string
+	// Write writes the component source with the Render body generated by Do.
+	Write(c *Converter, w io.Writer, generated, pkg, name string) error
+}
+
+// backends maps the -backend names to their Backend.
+var backends = map[string]Backend{
+	"vecty":  vectyBackend{},
+	"go-app": goAppBackend{},
+}
+
+type vectyBackend struct{}
+
+func (vectyBackend) Text(c *Converter, text string) string {
+	return fmt.Sprintf("vecty.Text(%q)", text)
+}
+
+func (vectyBackend) Element(c *Converter, tag string, attrSlice []attr, body string, indent int) string {
+	expr, attrSlice, dynamic := dynamicTag(tag, attrSlice)
+	e := fmt.Sprintf("vecty.Tag(%s, ", expr)
+	if !dynamic {
+		e = c.elem(tag)
+	}
+	body = c.attrs(tag, attrSlice, indent+1) + body
+	if len(body) == 0 {
+		return e + ")"
+	}
+	return fmt.Sprintf("%s%s\n%s)", e, body, strings.Repeat("\t", indent))
+}
+
+func (vectyBackend) Write(c *Converter, w io.Writer, generated, pkg, name string) error {
+	c.ExtModules[c.Vecty] = true
+	return templ.Execute(w, c.data(generated, pkg, name))
+}
+
+// goAppBackend writes github.com/maxence-charriere/go-app components, the
+// attributes are set by Attr except for class and id and the events are
+// bound by On.
+type goAppBackend struct{}
+
+func (goAppBackend) Text(c *Converter, text string) string {
+	return fmt.Sprintf("app.Text(%q)", text)
+}
+
+func (goAppBackend) Element(c *Converter, tag string, attrSlice []attr, body string, indent int) string {
+	e := fmt.Sprintf("app.Elem(%q)", tag)
+	if expr, rest, ok := dynamicTag(tag, attrSlice); ok {
+		e, attrSlice = fmt.Sprintf("app.Elem(%s)", expr), rest
+	} else if f, ok := goAppElems[tag]; ok {
+		e = "app." + f + "()"
+	} else if _, ok := elemNameMap[tag]; !ok && c.Strict && !isCustom(tag) {
+		log.Fatalln("unknown element:", tag)
+	}
+	for _, attr := range attrSlice {
+		k, v := attr.k, attr.v
+		if len(v) == 0 {
+			v = "true"
+		}
+		switch {
+		case k == "raw":
+		case k == "v-html":
+			// app.Raw needs a single root element in the html.
+			body = fmt.Sprintf("\n%sapp.Raw(%s),", strings.Repeat("\t", indent+1), attr.v)
+		case strings.HasPrefix(k, "@"):
+			mods := strings.Split(k[1:], ".")
+			l, _ := c.modifiers(c.handler(tag, mods[0], attr.v, "ctx app.Context, e app.Event", ""), mods[1:], true)
+			e += fmt.Sprintf(".On(%q, %s)", mods[0], l)
+		case strings.HasPrefix(k, ":") && k != ":class" && !strings.HasSuffix(k, ".if"):
+			e += fmt.Sprintf(".Attr(%q, %s)", k[1:], bound(k, attr.v))
+		case strings.HasPrefix(k, ":") || k == "v-bind":
+			log.Fatalln("go-app backend: bindings are not supported:", k)
+		case k == "class":
+			classes := []string{}
+			for _, s := range strings.Fields(v) {
+				classes = append(classes, fmt.Sprintf("%q", s))
+			}
+			e += fmt.Sprintf(".Class(%s)", strings.Join(classes, ", "))
+		case k == "id":
+			e += fmt.Sprintf(".ID(%q)", v)
+		case len(c.Scope) > 0 && k == c.Scope:
+			e += fmt.Sprintf(".Attr(%q, \"\")", k)
+		case c.Mapping.Booleans[k]:
+			e += fmt.Sprintf(".Attr(%q, %s)", k, v)
+		default:
+			e += fmt.Sprintf(".Attr(%q, %q)", k, v)
+		}
+	}
+	if len(body) == 0 {
+		return e
+	}
+	if _, ok := voidTags[tag]; ok {
+		log.Println("children of void element ignored:", tag)
+		return e
+	}
+	return fmt.Sprintf("%s.Body(%s\n%s)", e, body, strings.Repeat("\t", indent))
+}
+
+func (goAppBackend) Write(c *Converter, w io.Writer, generated, pkg, name string) error {
+	if len(c.Head) > 0 {
+		return fmt.Errorf("go-app backend: <head> is not supported, set it by app.Handler")
+	}
+	if len(c.Hooks) > 0 {
+		return fmt.Errorf("go-app backend: lifecycle hooks are not supported, implement app.Mounter")
+	}
+	c.ExtModules[goApp] = true
+	return goAppTempl.Execute(w, c.data(generated, pkg, name))
+}
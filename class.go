@@ -0,0 +1,149 @@
+package main
+
+import (
+	"fmt"
+	"go/parser"
+	"log"
+	"strconv"
+	"strings"
+)
+
+// splitTop splits s at the sep bytes outside of brackets and quotes.
+func splitTop(s string, sep byte) []string {
+	res := []string{}
+	depth := 0
+	quote := byte(0)
+	start := 0
+	for i := 0; i < len(s); i++ {
+		b := s[i]
+		switch {
+		case quote != 0:
+			if b == '\\' && quote != '`' {
+				i++
+			} else if b == quote {
+				quote = 0
+			}
+		case b == '"' || b == '\'' || b == '`':
+			quote = b
+		case b == '(' || b == '[' || b == '{':
+			depth++
+		case b == ')' || b == ']' || b == '}':
+			depth--
+		case b == sep && depth == 0:
+			res = append(res, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(res, s[start:])
+}
+
+// classExpr returns the go expression of a class name of the :class binding,
+// a single quoted string is a class name as in javascript.
+func classExpr(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		return strconv.Quote(s[1 : len(s)-1])
+	}
+	if _, err := parser.ParseExpr(s); err != nil {
+		log.Fatalf("invalid :class expression %q: %v", s, err)
+	}
+	return s
+}
+
+// classBinding returns the vecty.ClassMap entries of a :class binding, its
+// object form maps the class names to go boolean expressions and its array
+// form lists class names, objects and go string expressions.
+func classBinding(v string) [][2]string {
+	v = strings.TrimSpace(v)
+	res := [][2]string{}
+	switch {
+	case strings.HasPrefix(v, "{") && strings.HasSuffix(v, "}"):
+		for _, item := range splitTop(v[1:len(v)-1], ',') {
+			if len(strings.TrimSpace(item)) == 0 {
+				continue
+			}
+			kv := splitTop(item, ':')
+			if len(kv) != 2 {
+				log.Fatalf("invalid :class entry %q, use {name: cond}", strings.TrimSpace(item))
+			}
+			k := strings.TrimSpace(kv[0])
+			if s, err := strconv.Unquote(k); err == nil {
+				k = s
+			} else if len(k) >= 2 && k[0] == '\'' && k[len(k)-1] == '\'' {
+				k = k[1 : len(k)-1]
+			}
+			res = append(res, [2]string{strconv.Quote(k), classExpr(kv[1])})
+		}
+	case strings.HasPrefix(v, "[") && strings.HasSuffix(v, "]"):
+		for _, item := range splitTop(v[1:len(v)-1], ',') {
+			item = strings.TrimSpace(item)
+			switch {
+			case len(item) == 0:
+			case strings.HasPrefix(item, "{"):
+				res = append(res, classBinding(item)...)
+			default:
+				res = append(res, [2]string{classExpr(item), "true"})
+			}
+		}
+	default:
+		log.Fatalf("invalid :class binding %q, use {name: cond} or [names]", v)
+	}
+	return res
+}
+
+// classEntries returns the vecty.ClassMap entries of the static classes and
+// the :class binding, a static class stays set whatever its binding.
+func classEntries(static, binding string) [][2]string {
+	entries := [][2]string{}
+	fixed := map[string]bool{}
+	for _, s := range strings.Fields(static) {
+		k := strconv.Quote(s)
+		if !fixed[k] {
+			fixed[k] = true
+			entries = append(entries, [2]string{k, "true"})
+		}
+	}
+	seen := map[string]bool{}
+	for _, e := range classBinding(binding) {
+		if _, err := strconv.Unquote(e[0]); err == nil {
+			if fixed[e[0]] {
+				continue
+			}
+			if seen[e[0]] {
+				log.Fatalf("duplicate class %s in :class binding", e[0])
+			}
+			seen[e[0]] = true
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+// classMap returns the vecty.ClassMap of the static classes and the :class
+// binding.
+func classMap(static, binding string, indent int) string {
+	tab := strings.Repeat("\t", indent)
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "\n%svecty.ClassMap{", tab)
+	for _, e := range classEntries(static, binding) {
+		fmt.Fprintf(b, "\n%s\t%s: %s,", tab, e[0], e[1])
+	}
+	fmt.Fprintf(b, "\n%s},", tab)
+	return b.String()
+}
+
+// classNames returns the go expression of the escaped class attribute value
+// of the static classes and the :class binding.
+func classNames(static, binding string) string {
+	b := &strings.Builder{}
+	b.WriteString("func() string { s := []string{}; ")
+	for _, e := range classEntries(static, binding) {
+		if e[1] == "true" {
+			fmt.Fprintf(b, "s = append(s, %s); ", e[0])
+		} else {
+			fmt.Fprintf(b, "if %s { s = append(s, %s) }; ", e[1], e[0])
+		}
+	}
+	b.WriteString("return html.EscapeString(strings.Join(s, \" \")) }()")
+	return b.String()
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+const configFile = "vectygen.json"
+
+// Config is the project configuration read from vectygen.json, the command
+// line flags override it.
+type Config struct {
+	Package    string            `json:"package"`
+	Suffix     string            `json:"suffix"`
+	Naming     string            `json:"naming"`
+	Handlers   string            `json:"handlers"`
+	Backend    string            `json:"backend"`
+	Fragment   string            `json:"fragment"`
+	Target     string            `json:"target"`
+	Wasm       bool              `json:"wasm"`
+	Strict     bool              `json:"strict"`
+	Comments   bool              `json:"comments"`
+	Document   bool              `json:"document"`
+	HTML       bool              `json:"html"`
+	Scan       bool              `json:"scan"`
+	Mapping    *Mapping          `json:"mapping"`
+	Components map[string]string `json:"components"`
+}
+
+// findConfig returns the nearest vectygen.json from dir upward.
+func findConfig(dir string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	for {
+		name := filepath.Join(dir, configFile)
+		if _, err := os.Stat(name); err == nil {
+			return name
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// LoadConfig ...
+func LoadConfig(name string) (*Config, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	cfg := &Config{}
+	if err := json.NewDecoder(f).Decode(cfg); err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	return cfg, nil
+}
+
+// flags returns the command line flag values set by the config.
+func (cfg *Config) flags() map[string]string {
+	res := map[string]string{}
+	for k, v := range map[string]string{
+		"p":        cfg.Package,
+		"suffix":   cfg.Suffix,
+		"naming":   cfg.Naming,
+		"handlers": cfg.Handlers,
+		"backend":  cfg.Backend,
+		"fragment": cfg.Fragment,
+		"target":   cfg.Target,
+	} {
+		if len(v) > 0 {
+			res[k] = v
+		}
+	}
+	for k, b := range map[string]bool{
+		"strict":   cfg.Strict,
+		"wasm":     cfg.Wasm,
+		"comments": cfg.Comments,
+		"document": cfg.Document,
+		"html":     cfg.HTML,
+		"scan":     cfg.Scan,
+	} {
+		if b {
+			res[k] = "true"
+		}
+	}
+	return res
+}
+
+// apply sets the flags of fs the command line did not set to their config
+// values.
+func (cfg *Config) apply(fs *flag.FlagSet) error {
+	set := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	for k, v := range cfg.flags() {
+		if set[k] {
+			continue
+		}
+		if err := fs.Set(k, v); err != nil {
+			return fmt.Errorf("%s: %v", configFile, err)
+		}
+	}
+	return nil
+}
+
+// camel returns the go identifier of a dash or underscore separated name.
+func camel(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, p := range parts {
+		parts[i] = strings.Title(p)
+	}
+	return strings.Join(parts, "")
+}
+
+// componentNameOf returns the component name of a template base name.
+func componentNameOf(name, naming string) (string, error) {
+	switch naming {
+	case "title":
+		return strings.Title(name), nil
+	case "camel":
+		return camel(name), nil
+	}
+	return "", fmt.Errorf("unknown naming scheme: %q", naming)
+}
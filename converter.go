@@ -1,7 +1,11 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"io"
 	"log"
 	"strings"
@@ -16,7 +20,7 @@ var (
 		"address":    "elem.Address",
 		"area":       "elem.Area",
 		"article":    "elem.Article",
-		"aside":      "elem.ASide",
+		"aside":      "elem.Aside",
 		"audio":      "elem.Audio",
 		"b":          "elem.Bold",
 		"base":       "elem.Base",
@@ -72,11 +76,9 @@ var (
 		"map":        "elem.Map",
 		"mark":       "elem.Mark",
 		"menu":       "elem.Menu",
-		"menuitem":   "elem.MenuItem",
 		"meta":       "elem.Meta",
 		"meter":      "elem.Meter",
 		"nav":        "elem.Navigation",
-		"noframes":   "elem.NoFrames",
 		"noscript":   "elem.NoScript",
 		"object":     "elem.Object",
 		"ol":         "elem.OrderedList",
@@ -116,6 +118,7 @@ var (
 		"th":         "elem.TableHeader",
 		"thead":      "elem.TableHead",
 		"time":       "elem.Time",
+		"title":      "elem.Title",
 		"tr":         "elem.TableRow",
 		"track":      "elem.Track",
 		"u":          "elem.Underline",
@@ -124,11 +127,12 @@ var (
 		"video":      "elem.Video",
 		"wbr":        "elem.WordBreakOpportunity",
 	}
+	// prop.Disabled of vecty sets the "alt" property, disabled is a boolean
+	// vecty.Property.
 	propMap = map[string]string{
 		"alt":         "prop.Alt",
 		"autofocus":   "prop.Autofocus",
 		"checked":     "prop.Checked",
-		"disabled":    "prop.Disabled",
 		"for":         "prop.For",
 		"href":        "prop.Href",
 		"id":          "prop.ID",
@@ -142,6 +146,7 @@ var (
 		"autofocus": struct{}{},
 		"checked":   struct{}{},
 		"disabled":  struct{}{},
+		"hidden":    struct{}{},
 	}
 	inputTypes = map[string]struct{}{
 		"button":         struct{}{},
@@ -173,15 +178,16 @@ var (
 		"week":           struct{}{},
 	}
 	eventTypes = map[string]string{
+		"abort":                    "event.Abort",
 		"afterprint":               "event.AfterPrint",
 		"animationend":             "event.AnimationEnd",
 		"animationiteration":       "event.AnimationIteration",
 		"animationstart":           "event.AnimationStart",
 		"appinstalled":             "event.ApplicationInstalled",
-		"audioprocess":             "event.AudioProcess",
 		"audioend":                 "event.AudioEnd",
 		"audiostart":               "event.AudioStart",
 		"beforeprint":              "event.BeforePrint",
+		"beginEvent":               "event.BeginEvent",
 		"beforeunload":             "event.BeforeUnload",
 		"blocked":                  "event.Blocked",
 		"blur":                     "event.Blur",
@@ -196,7 +202,6 @@ var (
 		"click":                    "event.Click",
 		"close":                    "event.Close",
 		"complete":                 "event.Complete",
-		"compassneedscalibration":  "event.compassneedscalibration",
 		"compositionend":           "event.CompositionEnd",
 		"compositionstart":         "event.CompositionStart",
 		"compositionupdate":        "event.CompositionUpdate",
@@ -225,7 +230,7 @@ var (
 		"endEvent":                 "event.EndEvent",
 		"ended":                    "event.Ended",
 		"error":                    "event.Error",
-		"focus":                    "event.focus",
+		"focus":                    "event.Focus",
 		"focusin":                  "event.FocusIn",
 		"focusout":                 "event.FocusOut",
 		"fullscreenchange":         "event.FullScreenChange",
@@ -343,23 +348,67 @@ var (
 	}
 )
 
+// targets maps the -target names to the import path of their vecty API.
+var targets = map[string]string{
+	"legacy":  "github.com/gopherjs/vecty",
+	"current": "github.com/hexops/vecty",
+}
+
 // Converter ...
 type Converter struct {
+	// Name is the component name prefixing the generated event types.
+	Name       string
 	StdModules map[string]bool
 	ExtModules map[string]bool
-	Methods    map[string]string
+	Methods    map[string]*Handler
 	AppendCode []string
+	Hooks      []hook
+	Refs       []string
+	State      []string
+	// Portals are the <portal> elements rendered into their target.
+	Portals []portal
+	// Props are the "Name Type" fields declared by the props attribute.
+	Props  []string
+	Events map[string]bool
+	Head   []string
+	Scope  string
+	Style  string
+	HTML   []string
+	// Prerender generates the RenderHTML statements of the -html file.
+	Prerender    bool
+	HTMLModules  map[string]bool
+	Mapping      *Mapping
+	Backend      Backend
+	Vecty        string
+	BuildTag     string
+	Components   map[string]string
+	Handlers     string
+	KeepComments bool
+	Document     bool
+	Strict       bool
+	// Fragment is the tag wrapping multiple root nodes or "list".
+	Fragment string
+	// List is set by Do for the root nodes generated as the vecty.List of
+	// a RenderList method.
+	List bool
 }
 
 // New ...
 func New() *Converter {
 	return &Converter{
-		StdModules: map[string]bool{},
-		ExtModules: map[string]bool{
-			"github.com/gopherjs/vecty": true,
-		},
-		Methods:    map[string]string{},
-		AppendCode: []string{},
+		StdModules:  map[string]bool{},
+		ExtModules:  map[string]bool{},
+		Methods:     map[string]*Handler{},
+		AppendCode:  []string{},
+		Head:        []string{},
+		Mapping:     DefaultMapping(),
+		Backend:     vectyBackend{},
+		Vecty:       targets["legacy"],
+		Components:  map[string]string{},
+		Events:      map[string]bool{},
+		HTMLModules: map[string]bool{},
+		Handlers:    "dispatcher",
+		Fragment:    "div",
 	}
 }
 
@@ -379,7 +428,9 @@ func parseAttrs(z *html.Tokenizer) []attr {
 		key, val, more := z.TagAttr()
 		k := string(key)
 		v := string(val)
-		res = append(res, attr{k: k, v: v})
+		if len(k) > 0 {
+			res = append(res, attr{k: k, v: v})
+		}
 		if !more {
 			break
 		}
@@ -387,33 +438,90 @@ func parseAttrs(z *html.Tokenizer) []attr {
 	return res
 }
 
-func (c *Converter) attrs(attrSlice []attr, indent int) string {
-	tab0 := strings.Repeat("\t", indent)
+func (c *Converter) attrs(tag string, attrSlice []attr, indent int) string {
 	tab1 := strings.Repeat("\t", indent+1)
 	tab2 := strings.Repeat("\t", indent+2)
 	res := []string{}
+	conds := markupConds(attrSlice)
+	spans := []span{}
+	spread := ""
 	for _, attr := range attrSlice {
 		k := attr.k
 		v := attr.v
+		spans = append(spans, span{k: k, start: len(res)})
+		if strings.HasPrefix(k, ":") && strings.HasSuffix(k, ".if") {
+			continue
+		}
+		if k == "v-bind" {
+			spread = c.spread(v)
+			res = append(res, spreadMark)
+			continue
+		}
 		if len(v) == 0 {
 			v = "true"
 		}
+		if k == "raw" {
+			continue
+		}
+		if len(c.Scope) > 0 && k == c.Scope {
+			res = append(res, fmt.Sprintf("\n%svecty.Attribute(%q, \"\"),", tab1, k))
+			continue
+		}
+		if k == "v-html" {
+			res = append(res, fmt.Sprintf("\n%svecty.UnsafeHTML(%s),", tab1, attr.v))
+			continue
+		}
 		if strings.HasPrefix(k, "@") {
 			// event mapping
-			name := k[1:]
-			statement, ok := eventTypes[name]
+			mods := strings.Split(k[1:], ".")
+			name := mods[0]
+			if i := strings.Index(name, ":"); i >= 0 && len(globals[name[:i]]) > 0 {
+				log.Fatalf("@%s is only supported on the root element", name)
+			}
+			l, calls := c.modifiers(c.handler(tag, name, attr.v, "e *vecty.Event", valueKind(tag, attrSlice)), mods[1:], false)
+			statement, ok := c.Mapping.Events[name]
 			if !ok {
-				log.Fatalln("unknown event:", name)
+				if c.Strict && !strings.Contains(name, "-") {
+					log.Fatalln("unknown event:", name)
+				}
+				e := fmt.Sprintf("&vecty.EventListener{Name: %q, Listener: %s}", name, l)
+				if len(calls) > 0 {
+					e = "(" + e + ")" + calls
+				}
+				res = append(res, fmt.Sprintf("\n%s%s,", tab1, e))
+				continue
+			}
+			res = append(res, fmt.Sprintf("\n%s%s(%s)%s,", tab1, c.qualify(statement), l, calls))
+			continue
+		}
+		if k == ":class" {
+			static, _ := (&node{attrs: attrSlice}).attr("class")
+			res = append(res, classMap(static, attr.v, indent+1))
+			continue
+		}
+		if k == "class" && hasAttr(attrSlice, ":class") {
+			continue
+		}
+		if strings.HasPrefix(k, ":") {
+			// a bound attribute sets its go expression.
+			if isCustom(tag) {
+				res = append(res, fmt.Sprintf("\n%svecty.Attribute(%q, %s),", tab1, k[1:], bound(k, v)))
+			} else {
+				res = append(res, fmt.Sprintf("\n%svecty.Property(%q, %s),", tab1, k[1:], bound(k, v)))
 			}
-			c.Methods[name] = v
-			res = append(res, fmt.Sprintf("\n%s%s(c.%s),", tab1, statement, v))
-			c.ExtModules["github.com/gopherjs/vecty/event"] = true
 			continue
 		}
 		if k == "class" {
 			classes := []string{}
-			for _, s := range strings.Split(v, " ") {
-				classes = append(classes, fmt.Sprintf("%q", s))
+			seen := map[string]bool{}
+			for _, s := range strings.Fields(attr.v) {
+				if !seen[s] {
+					seen[s] = true
+					classes = append(classes, fmt.Sprintf("%q", s))
+				}
+			}
+			if len(classes) == 0 {
+				continue
 			}
 			if len(classes) <= 4 {
 				res = append(res, fmt.Sprintf("\n%svecty.Class(%s),", tab1, strings.Join(classes, ", ")))
@@ -424,16 +532,18 @@ func (c *Converter) attrs(attrSlice []attr, indent int) string {
 				}
 				res = append(res, fmt.Sprintf("\n%s},", tab1))
 			}
-		} else if prop, ok := propMap[k]; ok {
-			if _, ok := propBool[k]; ok {
-				res = append(res, fmt.Sprintf("\n%s%s(%s),", tab1, prop, v))
+		} else if isCustom(tag) {
+			// web components read their attributes.
+			res = append(res, fmt.Sprintf("\n%svecty.Attribute(%q, %q),", tab1, k, attr.v))
+		} else if prop, ok := c.Mapping.Properties[k]; ok {
+			if c.Mapping.Booleans[k] {
+				res = append(res, fmt.Sprintf("\n%s%s(%s),", tab1, c.qualify(prop), v))
 			} else {
-				res = append(res, fmt.Sprintf("\n%s%s(%q),", tab1, prop, v))
+				res = append(res, fmt.Sprintf("\n%s%s(%q),", tab1, c.qualify(prop), v))
 			}
-			c.ExtModules["github.com/gopherjs/vecty/prop"] = true
 		} else {
-			if _, ok := propBool[k]; ok {
-				res = append(res, fmt.Sprintf("\n%s%s(%s),", tab1, prop, v))
+			if c.Mapping.Booleans[k] {
+				res = append(res, fmt.Sprintf("\n%svecty.Property(%q, %s),", tab1, k, v))
 			} else {
 				res = append(res, fmt.Sprintf("\n%svecty.Property(%q, %q),", tab1, k, v))
 			}
@@ -442,100 +552,349 @@ func (c *Converter) attrs(attrSlice []attr, indent int) string {
 	if len(res) == 0 {
 		return ""
 	}
-	return fmt.Sprintf("\n%svecty.Markup(%s\n%s),", tab0, strings.Join(res, ""), tab0)
+	return markupList(markupIf(res, spans, conds, indent+1), spread, indent)
 }
 
-func (c *Converter) generate(w io.Writer, r io.Reader) (err error) {
-	indent := 1
-	isGo := false
-	z := html.NewTokenizer(r)
-	for err == nil {
-		tt := z.Next()
-		switch tt {
-		case html.ErrorToken:
-			err = z.Err()
-			if err == io.EOF {
-				break
-			}
-			return
-		case html.CommentToken:
-		case html.DoctypeToken:
-		case html.TextToken:
-			if isGo {
-				c.AppendCode = append(c.AppendCode, string(z.Text()))
-				continue
-			}
-			tab := strings.Repeat("\t", indent)
-			t := strings.TrimSpace(string(z.Text()))
-			if len(t) > 0 {
-				fmt.Fprintf(w, "\n%svecty.Text(%q),", tab, t)
-			}
-		case html.StartTagToken:
-			tab := strings.Repeat("\t", indent)
-			indent++
-			attrSlice := parseAttrs(z)
-			tag := c.tag(z)
-			e, ok := elemNameMap[tag]
-			if !ok {
-				e = fmt.Sprintf("vecty.Tag(%q, ", tag)
-			}
-			log.Println(tag, attrSlice)
-			if tag == "script" {
-				for _, attr := range attrSlice {
-					if attr.k == "type" && attr.v == "application/x-go" {
-						isGo = true
-					}
-				}
-				if isGo {
-					continue
-				}
-			}
-			a, t := c.attrs(attrSlice, indent), string(z.Text())
-			if indent > 2 {
-				fmt.Fprint(w, "\n")
+// isCustom reports whether tag is a custom element name.
+func isCustom(tag string) bool {
+	return strings.Contains(tag, "-")
+}
+
+func (c *Converter) elem(tag string) string {
+	e, ok := c.Mapping.Elements[tag]
+	if !ok {
+		if c.Strict && !isCustom(tag) {
+			log.Fatalln("unknown element:", tag)
+		}
+		return fmt.Sprintf("vecty.Tag(%q, ", tag)
+	}
+	return c.qualify(e) + "("
+}
+
+// withoutAttr returns attrSlice without the key attributes.
+func withoutAttr(attrSlice []attr, key string) []attr {
+	res := []attr{}
+	for _, attr := range attrSlice {
+		if attr.k != key {
+			res = append(res, attr)
+		}
+	}
+	return res
+}
+
+func hasAttr(attrSlice []attr, key string) bool {
+	for _, attr := range attrSlice {
+		if attr.k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// goString returns s as a go string literal.
+func goString(s string) string {
+	if strings.Contains(s, "\n") && !strings.ContainsAny(s, "`\r") {
+		return "`" + s + "`"
+	}
+	return fmt.Sprintf("%q", s)
+}
+
+// rawAttrs returns the markup of a raw element, its inner html is rendered by
+// vecty.UnsafeHTML.
+func rawAttrs(n *node) []attr {
+	attrSlice := []attr{}
+	for _, attr := range n.attrs {
+		if attr.k != "raw" {
+			attrSlice = append(attrSlice, attr)
+		}
+	}
+	if _, ok := voidTags[n.tag]; !ok && !hasAttr(attrSlice, "v-html") {
+		attrSlice = append(attrSlice, attr{k: "v-html", v: goString(strings.TrimSpace(n.text))})
+	}
+	return attrSlice
+}
+
+// component returns the expression of a registered component, the attributes
+// are set to the string fields of the same name.
+func (c *Converter) component(n *node) string {
+	t := c.Name
+	if !c.isSelf(n.tag) {
+		t = c.qualify(c.Components[n.tag])
+	}
+	fields := c.fields(n)
+	if c.isSelf(n.tag) && c.Handlers != "methods" {
+		fields = append(fields, "dispatcher: c.dispatcher")
+	}
+	return fmt.Sprintf("&%s{%s}", t, strings.Join(fields, ", "))
+}
+
+// fields returns the field values of a component element, the :field
+// attributes are go expressions.
+func (c *Converter) fields(n *node) []string {
+	fields := []string{}
+	for _, attr := range n.attrs {
+		k, v := attr.k, fmt.Sprintf("%q", attr.v)
+		switch {
+		case k == "v-if" || k == "v-for":
+			continue
+		case strings.HasPrefix(k, ":"):
+			k, v = k[1:], attr.v
+		}
+		if c.isSelf(n.tag) && !c.hasProp(camel(k)) {
+			log.Fatalf("unknown prop %s of <%s>, declare it in the props of the root element", camel(k), n.tag)
+		}
+		fields = append(fields, fmt.Sprintf("%s: %s", camel(k), v))
+	}
+	return fields
+}
+
+// listener is the go func handling an event.
+type listener struct {
+	// method is the bound component method, "" for a func literal.
+	method string
+	// params and body are the func literal, ev names its event param.
+	params, body, ev string
+}
+
+func (l listener) String() string {
+	if len(l.method) > 0 {
+		return "c." + l.method
+	}
+	return fmt.Sprintf("func(%s) { %s }", l.params, l.body)
+}
+
+// handler returns the listener of an event attribute, a method name is bound
+// to the component, a c.Method value is the handler itself and any other go
+// statements run in a func literal with the params. The e of the statements
+// is the generated event type of a value kind, the methods get the type to
+// wrap their event.
+func (c *Converter) handler(tag, name, v, params, kind string) listener {
+	if len(strings.TrimSpace(v)) == 0 {
+		log.Fatalf("empty handler @%s", name)
+	}
+	args := []string{}
+	for _, p := range strings.Split(params, ",") {
+		args = append(args, strings.Fields(p)[0])
+	}
+	if token.IsIdentifier(v) {
+		if len(kind) > 0 {
+			c.eventType(kind)
+		}
+		c.bind(tag, name, v)
+		return listener{method: v, params: params, body: fmt.Sprintf("c.%s(%s)", v, strings.Join(args, ", ")), ev: "e"}
+	}
+	if m := strings.TrimPrefix(v, "c."); m != v && token.IsIdentifier(m) {
+		// a method value of the component is its own handler.
+		if len(kind) > 0 {
+			c.eventType(kind)
+		}
+		return listener{method: m, params: params, body: fmt.Sprintf("c.%s(%s)", m, strings.Join(args, ", ")), ev: "e"}
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), "", "package p; func _() {"+v+"\n}", 0)
+	if err != nil {
+		log.Fatalf("invalid handler @%s=%q: %v", name, v, err)
+	}
+	usesEvent := false
+	names := map[*ast.Ident]bool{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		if s, ok := n.(*ast.ExprStmt); ok {
+			x := s.X
+			for p, ok := x.(*ast.ParenExpr); ok; p, ok = x.(*ast.ParenExpr) {
+				x = p.X
 			}
-			fmt.Fprintf(w, "%s%s(%s", tab, e, a)
-			if len(t) > 0 {
-				fmt.Fprintf(w, "\n%svecty.Text(%q),", tab, t)
+			if _, ok := x.(*ast.CallExpr); !ok {
+				log.Fatalf("invalid handler @%s=%q: use a method name or go statements, an expression statement is a call", name, v)
 			}
-		case html.SelfClosingTagToken:
-			tab := strings.Repeat("\t", indent)
-			indent++
-			tag := c.tag(z)
-			a := c.attrs(parseAttrs(z), indent)
-			e, ok := elemNameMap[tag]
-			if !ok {
-				e = fmt.Sprintf("\n%svecty.Tag(%q, ", tab, tag)
-			} else {
-				e = e + "("
-				c.ExtModules["github.com/gopherjs/vecty/elem"] = true
+		}
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			// the e of x.e and T{e: v} is a field or method name.
+			names[n.Sel] = true
+		case *ast.KeyValueExpr:
+			if id, ok := n.Key.(*ast.Ident); ok {
+				names[id] = true
 			}
-			if len(a) > 0 {
-				fmt.Fprintf(w, "\n%[1]s%s%s\n%[1]s),", tab, e, a)
-			} else {
-				fmt.Fprintf(w, "\n%[1]s%s),", tab, e)
+		case *ast.Ident:
+			if n.Name == "e" && !names[n] {
+				usesEvent = true
 			}
-			indent--
-		case html.EndTagToken:
-			indent--
-			if isGo {
-				isGo = false
-				continue
+		}
+		return true
+	})
+	if len(kind) > 0 && usesEvent {
+		return listener{params: "ev *vecty.Event", body: fmt.Sprintf("e := %s{ev}; %s", c.eventType(kind), v), ev: "ev"}
+	}
+	return listener{params: params, body: v, ev: "e"}
+}
+
+// ref adds the field of a ref attribute.
+func (c *Converter) ref(name string) string {
+	if !token.IsIdentifier(name) {
+		log.Fatalln("invalid ref:", name)
+	}
+	for _, r := range c.Refs {
+		if r == name {
+			log.Fatalln("duplicate ref:", name)
+		}
+	}
+	c.Refs = append(c.Refs, name)
+	return name
+}
+
+func (c *Converter) comment(w io.Writer, text string, indent int) {
+	text = strings.TrimSpace(text)
+	if len(text) == 0 {
+		return
+	}
+	if indent == 1 {
+		// top level comments must not break the return statement.
+		fmt.Fprintf(w, "/* %s */ ", strings.Replace(text, "*/", "* /", -1))
+		return
+	}
+	tab := strings.Repeat("\t", indent)
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			fmt.Fprintf(w, "\n%s//", tab)
+			continue
+		}
+		fmt.Fprintf(w, "\n%s// %s", tab, line)
+	}
+}
+
+func (c *Converter) generate(w io.Writer, n *node, indent int) {
+	tab := strings.Repeat("\t", indent)
+	prefix, sep := "\n"+tab, ","
+	if indent == 1 {
+		prefix, sep = "", ""
+	}
+	switch {
+	case n.comment:
+		if c.KeepComments {
+			c.comment(w, n.text, indent)
+		}
+	case !n.isElement():
+		t := strings.TrimSpace(n.text)
+		if len(t) > 0 {
+			fmt.Fprintf(w, "%s%s%s", prefix, c.Backend.Text(c, t), sep)
+		}
+	case c.isSelf(n.tag) || len(c.Components[n.tag]) > 0:
+		if len(n.children) > 0 {
+			log.Println("children of component ignored:", n.tag)
+		}
+		fmt.Fprintf(w, "%s%s%s", prefix, c.guard(n, c.component(n), indent), sep)
+	case n.tag == "component":
+		fmt.Fprintf(w, "%s%s%s", prefix, c.guard(n, dynamicComponent(n), indent), sep)
+	default:
+		tag, attrSlice := n.tag, n.attrs
+		if n.isRaw() {
+			attrSlice = rawAttrs(n)
+			if tag == "raw" {
+				tag = "div"
 			}
-			tab := strings.Repeat("\t", indent)
-			fmt.Fprintf(w, "\n%s)", tab)
-			if indent > 1 {
-				fmt.Fprint(w, ",")
+		}
+		if len(c.Scope) > 0 {
+			attrSlice = append(attrSlice[:len(attrSlice):len(attrSlice)], attr{k: c.Scope})
+		}
+		ref, hasRef := n.attr("ref")
+		if hasRef {
+			attrSlice = withoutAttr(attrSlice, "ref")
+		}
+		attrSlice = withoutAttr(withoutAttr(attrSlice, "v-if"), "v-for")
+		body := bytes.NewBuffer(nil)
+		if !n.isRaw() {
+			for _, child := range n.children {
+				c.generate(body, child, indent+1)
 			}
 		}
+		e := c.Backend.Element(c, tag, attrSlice, body.String(), indent)
+		if hasRef {
+			e = fmt.Sprintf("c.setRef(&c.%s, %s)", c.ref(ref), e)
+		}
+		fmt.Fprintf(w, "%s%s%s", prefix, c.guard(n, e, indent), sep)
 	}
-	return nil
+}
+
+// fragment wraps multiple roots into an element of the Fragment tag, it
+// reports whether they are rendered as a vecty.List instead. Render returns
+// them in a div then, a parent embeds the RenderList result.
+func (c *Converter) fragment(root *node) (bool, error) {
+	roots := 0
+	for _, n := range root.children {
+		if n.isElement() || (!n.comment && len(strings.TrimSpace(n.text)) > 0) {
+			roots++
+		}
+	}
+	switch {
+	case roots == 0:
+		return false, fmt.Errorf("no root element to render")
+	case roots == 1:
+		return false, nil
+	case c.Fragment == "list":
+		if _, ok := c.Backend.(vectyBackend); !ok {
+			return false, fmt.Errorf("%d root nodes: list fragments need the vecty backend", roots)
+		}
+		return true, nil
+	}
+	wrapper := &node{tag: c.Fragment}
+	for _, n := range root.children {
+		wrapper.add(n)
+	}
+	root.children = nil
+	root.add(wrapper)
+	return false, nil
 }
 
 // Do ...
 func (c *Converter) Do(output io.Writer, input io.Reader, pkg string) error {
-	if err := c.generate(output, input); err != nil && err != io.EOF {
+	root, err := c.parse(input)
+	if err != nil {
 		return err
 	}
+	c.scoped(root)
+	if c.Document {
+		c.document(root)
+	}
+	if err := c.hooks(root); err != nil {
+		return err
+	}
+	if err := c.props(root); err != nil {
+		return err
+	}
+	list, err := c.fragment(root)
+	if err != nil {
+		return err
+	}
+	for _, n := range append([]*node{root}, c.portalNodes()...) {
+		if err := c.checkRecursion(n, false); err != nil {
+			return err
+		}
+		if err := c.checkLoops(n, false); err != nil {
+			return err
+		}
+	}
+	c.List = list
+	if list {
+		body := bytes.NewBuffer(nil)
+		for _, n := range root.children {
+			c.generate(body, n, 2)
+		}
+		fmt.Fprintf(output, "vecty.List{%s\n\t}", body)
+	} else {
+		for _, n := range root.children {
+			c.generate(output, n, 1)
+		}
+	}
+	for i, p := range c.Portals {
+		body := bytes.NewBuffer(nil)
+		c.generate(body, p.node, 1)
+		c.Portals[i].Render = body.String()
+	}
+	if err := c.checkHandlers(); err != nil {
+		return err
+	}
+	if c.Prerender {
+		c.HTML = c.html(root)
+	}
 	return nil
 }
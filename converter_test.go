@@ -0,0 +1,410 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// options sets the converter flags of the testdata/<name>.html cases.
+var options = map[string]func(c *Converter){
+	"comments": func(c *Converter) { c.KeepComments = true },
+	"components": func(c *Converter) {
+		c.Components["todo-item"] = "TodoItem"
+		c.Components["ui-panel"] = "example.com/ui/widgets.Panel"
+		c.Handlers = "methods"
+		c.Strict = true
+	},
+	"document":   func(c *Converter) { c.Document = true },
+	"goapp":      func(c *Converter) { c.Backend = backends["go-app"] },
+	"goappstate": func(c *Converter) { c.Backend = backends["go-app"] },
+	"list":       func(c *Converter) { c.Fragment = "list" },
+	"wasm": func(c *Converter) {
+		c.Vecty = targets["current"]
+		c.BuildTag = "js && wasm"
+	},
+	"mapping": func(c *Converter) {
+		if err := c.Mapping.LoadFile(filepath.Join("testdata", "mapping.json")); err != nil {
+			panic(err)
+		}
+	},
+}
+
+// stubImporter imports the type checking stubs in testdata/stub.
+type stubImporter struct {
+	fset *token.FileSet
+	pkgs map[string]*types.Package
+}
+
+func (s *stubImporter) Import(path string) (*types.Package, error) {
+	if pkg, ok := s.pkgs[path]; ok {
+		return pkg, nil
+	}
+	dir := filepath.Join("testdata", "stub", filepath.FromSlash(path))
+	if _, err := os.Stat(dir); err != nil {
+		return importer.Default().Import(path)
+	}
+	pkgs, err := parser.ParseDir(s.fset, dir, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	files := []*ast.File{}
+	for _, p := range pkgs {
+		for _, f := range p.Files {
+			files = append(files, f)
+		}
+	}
+	conf := types.Config{Importer: s}
+	pkg, err := conf.Check(path, s.fset, files, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.pkgs[path] = pkg
+	return pkg, nil
+}
+
+// typeCheck checks src together with the user code of testdata/<name>_user.go.
+func typeCheck(t *testing.T, name string, src []byte) {
+	t.Helper()
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, name, src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := []*ast.File{f}
+	user := strings.TrimSuffix(name, ".golden.go") + "_user.go"
+	if _, err := os.Stat(user); err == nil {
+		f, err := parser.ParseFile(fset, user, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, f)
+	}
+	conf := types.Config{Importer: &stubImporter{fset: fset, pkgs: map[string]*types.Package{}}}
+	if _, err := conf.Check("main", fset, files, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func convert(t *testing.T, name string) ([]byte, []byte) {
+	t.Helper()
+	input, err := os.Open(filepath.Join("testdata", name+".html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer input.Close()
+	return convertFrom(t, name, input)
+}
+
+// convertFrom converts input with the options of the name case, it returns
+// the component source and its RenderHTML source.
+func convertFrom(t *testing.T, name string, input io.Reader) ([]byte, []byte) {
+	t.Helper()
+	c := New()
+	c.Name = strings.Title(name)
+	c.Prerender = true
+	if opt, ok := options[name]; ok {
+		opt(c)
+	}
+	buffer := bytes.NewBuffer(nil)
+	if err := c.Do(buffer, input, "main"); err != nil {
+		t.Fatal(err)
+	}
+	output := bytes.NewBuffer(nil)
+	if err := c.Write(output, buffer.String(), "main", strings.Title(name)); err != nil {
+		t.Fatal(err)
+	}
+	prerendered := bytes.NewBuffer(nil)
+	if err := c.WriteHTML(prerendered, "main", strings.Title(name)); err != nil {
+		t.Fatal(err)
+	}
+	return output.Bytes(), prerendered.Bytes()
+}
+
+func TestGolden(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "*.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".html")
+		t.Run(name, func(t *testing.T) {
+			golden := filepath.Join("testdata", name+".golden.go")
+			got, _ := convert(t, name)
+			if *update {
+				if err := ioutil.WriteFile(golden, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s differs from %s:\n%s", file, golden, got)
+			}
+			typeCheck(t, golden, got)
+		})
+	}
+}
+
+// TestPrerender checks the RenderHTML sources of the testdata cases against
+// testdata/prerender/<name>.golden.go, with the user code of
+// testdata/prerender/<name>_user.go.
+func TestPrerender(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "*.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".html")
+		if strings.HasPrefix(name, "goapp") {
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			golden := filepath.Join("testdata", "prerender", name+".golden.go")
+			_, got := convert(t, name)
+			if *update {
+				if err := ioutil.WriteFile(golden, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s differs from %s:\n%s", file, golden, got)
+			}
+			typeCheck(t, golden, got)
+		})
+	}
+}
+
+func TestScanDir(t *testing.T) {
+	m := &Mapping{
+		Elements:   map[string]string{},
+		Properties: map[string]string{},
+		Booleans:   map[string]bool{},
+		Events:     map[string]string{},
+	}
+	if err := m.scanDir(filepath.Join("testdata", "scan", "prop")); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"alt":     "prop.Alt",
+		"checked": "prop.Checked",
+		"for":     "prop.For",
+	}
+	if fmt.Sprint(m.Properties) != fmt.Sprint(want) {
+		t.Errorf("got %v, want %v", m.Properties, want)
+	}
+	if !m.Booleans["checked"] || m.Booleans["alt"] {
+		t.Errorf("unexpected booleans %v", m.Booleans)
+	}
+}
+
+func TestConfigFlags(t *testing.T) {
+	for _, tc := range []struct {
+		cfg  Config
+		want map[string]string
+	}{
+		{Config{}, map[string]string{}},
+		{Config{Package: "views", Naming: "camel"}, map[string]string{"p": "views", "naming": "camel"}},
+		{Config{Backend: "go-app", Strict: true}, map[string]string{"backend": "go-app", "strict": "true"}},
+		{Config{Target: "current", Wasm: true, HTML: true}, map[string]string{"target": "current", "wasm": "true", "html": "true"}},
+		{Config{Fragment: "list", Comments: false, Scan: true}, map[string]string{"fragment": "list", "scan": "true"}},
+	} {
+		if got := tc.cfg.flags(); fmt.Sprint(got) != fmt.Sprint(tc.want) {
+			t.Errorf("%+v: got %v, want %v", tc.cfg, got, tc.want)
+		}
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	want, err := filepath.Abs(filepath.Join("testdata", configFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := findConfig(filepath.Join("testdata", "scan", "prop")); got != want {
+		t.Errorf("findConfig: got %q, want %q", got, want)
+	}
+	cfg, err := LoadConfig(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Package != "views" || cfg.Handlers != "methods" || !cfg.Strict || cfg.Components["todo-item"] != "TodoItem" {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+	if _, err := LoadConfig(filepath.Join("testdata", "mapping.html")); err == nil {
+		t.Error("want an error for an invalid config")
+	}
+	// the flags of the command line take precedence over the config.
+	fs := flag.NewFlagSet("vectygen", flag.ContinueOnError)
+	pkg := fs.String("p", "main", "")
+	naming := fs.String("naming", "title", "")
+	strict := fs.Bool("strict", false, "")
+	for _, name := range []string{"suffix", "handlers", "fragment"} {
+		fs.String(name, "", "")
+	}
+	html := fs.Bool("html", false, "")
+	if err := fs.Parse([]string{"-p", "cmd", "-html=false"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.apply(fs); err != nil {
+		t.Fatal(err)
+	}
+	if *pkg != "cmd" || *naming != "camel" || !*strict || *html {
+		t.Errorf("got -p %s -naming %s -strict %v -html %v", *pkg, *naming, *strict, *html)
+	}
+}
+
+func TestDynamicComponentAttrs(t *testing.T) {
+	b := bytes.NewBuffer(nil)
+	log.SetOutput(b)
+	defer log.SetOutput(os.Stderr)
+	dynamicComponent(&node{tag: "component", attrs: []attr{{k: ":is", v: "c.Current()"}, {k: "v-if", v: "c.Open"}}})
+	if b.Len() > 0 {
+		t.Errorf("unexpected warning %q", b)
+	}
+	dynamicComponent(&node{tag: "component", attrs: []attr{{k: ":is", v: "c.Current()"}, {k: "class", v: "x"}, {k: "v-for", v: "x in c.Items"}}})
+	if !strings.Contains(b.String(), "ignored: class\n") {
+		t.Errorf("want the ignored class attribute, got %q", b)
+	}
+}
+
+func TestHandlerEvent(t *testing.T) {
+	for v, typed := range map[string]bool{
+		"c.SetName(e.Value())":      true,
+		"c.SetName(c.e)":            false,
+		"c.Set(Item{e: c.Name})":    false,
+		"c.Set(Item{e: e.Value()})": true,
+		"c.e.Set(c.Name)":           false,
+	} {
+		c := New()
+		c.Name = "Form"
+		l := c.handler("input", "input", v, "e *vecty.Event", "Text")
+		if got := strings.Contains(l.body, "FormTextEvent{ev}"); got != typed {
+			t.Errorf("%s: got the typed event %v, want %v", v, got, typed)
+		}
+	}
+}
+
+func TestUnboundedRecursion(t *testing.T) {
+	c := New()
+	c.Name = "TreeNode"
+	src := `<li props="Node *TreeData"><span>node</span><ul><tree-node :node="c.Node"></tree-node></ul></li>`
+	err := c.Do(bytes.NewBuffer(nil), strings.NewReader(src), "main")
+	if err == nil || !strings.Contains(err.Error(), "unbounded recursion") {
+		t.Errorf("want an unbounded recursion error, got %v", err)
+	}
+}
+
+func TestLoopState(t *testing.T) {
+	for _, src := range []string{
+		`<ul><li v-for="item in c.Items" ref="last">item</li></ul>`,
+		`<ul><li v-for="item in c.Items"><button @click.once="c.Clear()">x</button></li></ul>`,
+		`<ul><li v-for="item in c.Items" @input.debounce.300ms="c.Clear()">x</li></ul>`,
+	} {
+		c := New()
+		c.Name = "Loop"
+		err := c.Do(bytes.NewBuffer(nil), strings.NewReader(src), "main")
+		if err == nil || !strings.Contains(err.Error(), "under v-for") {
+			t.Errorf("%s: want an error for the state under v-for, got %v", src, err)
+		}
+	}
+}
+
+// render returns the source of the Render and RenderList methods of a
+// generated file.
+func render(t *testing.T, src []byte) string {
+	t.Helper()
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := ""
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && (fn.Name.Name == "Render" || fn.Name.Name == "RenderList") {
+			res += string(src[fset.Position(fn.Pos()).Offset:fset.Position(fn.End()).Offset])
+		}
+	}
+	if len(res) == 0 {
+		t.Fatal("no Render method")
+	}
+	return res
+}
+
+// lossy are the cases reversed without the html only the forward conversion
+// reads: comments, modifier guards, portals, scoped styles and v-for loops.
+var lossy = map[string]bool{
+	"comments":  true,
+	"modifiers": true,
+	"portal":    true,
+	"scoped":    true,
+	"treenode":  true,
+}
+
+func TestReverse(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "*.golden.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".golden.go")
+		if strings.HasPrefix(name, "goapp") {
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			src, err := ioutil.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := New()
+			if opt, ok := options[name]; ok {
+				opt(c)
+			}
+			got := bytes.NewBuffer(nil)
+			r := NewReverser(c.Mapping)
+			r.Components = c.Components
+			if err := r.Do(got, bytes.NewReader(src), file); err != nil {
+				t.Fatal(err)
+			}
+			golden := filepath.Join("testdata", "reverse", name+".html")
+			if *update {
+				if err := ioutil.WriteFile(golden, got.Bytes(), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got.Bytes(), want) {
+				t.Errorf("%s differs from %s:\n%s", file, golden, got)
+			}
+			if lossy[name] {
+				return
+			}
+			back, _ := convertFrom(t, name, got)
+			if r := render(t, back); r != render(t, src) {
+				t.Errorf("the Render of %s converted back differs:\n%s", golden, r)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+var headTags = map[string]struct{}{
+	"base":  struct{}{},
+	"link":  struct{}{},
+	"meta":  struct{}{},
+	"title": struct{}{},
+}
+
+// document rewrites a full html document into a single <body> root, the
+// contents of <head> and every <script src> become AddHead statements.
+func (c *Converter) document(root *node) {
+	top := []*node{}
+	for _, n := range root.children {
+		if n.tag == "html" {
+			top = append(top, n.children...)
+			continue
+		}
+		top = append(top, n)
+	}
+	var body *node
+	rest := &node{tag: "body"}
+	for _, n := range top {
+		if _, ok := headTags[n.tag]; ok {
+			c.head(n)
+			continue
+		}
+		switch n.tag {
+		case "head":
+			for _, child := range n.children {
+				c.head(child)
+			}
+		case "body":
+			body = n
+		default:
+			rest.add(n)
+		}
+	}
+	if body == nil {
+		body = rest
+	} else {
+		// the nodes outside of <body> are kept at its end.
+		for _, n := range rest.children {
+			if n.isElement() {
+				log.Printf("<%s> outside of <body> moved into it", n.tag)
+			}
+			body.add(n)
+		}
+	}
+	c.scripts(body)
+	root.children = nil
+	root.add(body)
+}
+
+// scripts moves external scripts out of the render tree.
+func (c *Converter) scripts(n *node) {
+	for _, child := range append([]*node{}, n.children...) {
+		if _, ok := child.attr("src"); ok && child.tag == "script" {
+			c.head(child)
+			n.remove(child)
+			continue
+		}
+		c.scripts(child)
+	}
+}
+
+func (c *Converter) head(n *node) {
+	if !n.isElement() {
+		return
+	}
+	rel, _ := n.attr("rel")
+	href, ok := n.attr("href")
+	switch {
+	case n.tag == "title":
+		c.Head = append(c.Head, fmt.Sprintf("vecty.SetTitle(%q)", strings.TrimSpace(n.innerText())))
+	case n.tag == "link" && rel == "stylesheet" && ok:
+		c.Head = append(c.Head, fmt.Sprintf("vecty.AddStylesheet(%q)", href))
+	default:
+		c.StdModules["syscall/js"] = true
+		lines := []string{
+			fmt.Sprintf("e := js.Global().Get(\"document\").Call(\"createElement\", %q)", n.tag),
+		}
+		for _, attr := range n.attrs {
+			lines = append(lines, fmt.Sprintf("e.Call(\"setAttribute\", %q, %q)", attr.k, attr.v))
+		}
+		if text := strings.TrimSpace(n.innerText()); len(text) > 0 {
+			lines = append(lines, fmt.Sprintf("e.Set(\"textContent\", %s)", goString(text)))
+		}
+		lines = append(lines, "js.Global().Get(\"document\").Get(\"head\").Call(\"appendChild\", e)")
+		c.Head = append(c.Head, "{\n\t\t"+strings.Join(lines, "\n\t\t")+"\n\t}")
+	}
+}
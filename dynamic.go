@@ -0,0 +1,48 @@
+package main
+
+import (
+	"go/parser"
+	"log"
+	"strings"
+)
+
+// dynamicComponent returns the vecty.ComponentOrHTML expression of the :is
+// attribute of a <component>.
+func dynamicComponent(n *node) string {
+	is, ok := n.attr(":is")
+	if !ok || len(strings.TrimSpace(is)) == 0 {
+		log.Fatalln("<component> without :is")
+	}
+	if _, err := parser.ParseExpr(is); err != nil {
+		log.Fatalf("invalid :is=%q: %v", is, err)
+	}
+	ignored := []string{}
+	for _, attr := range n.attrs {
+		if attr.k != ":is" && attr.k != "v-if" && attr.k != "v-for" {
+			ignored = append(ignored, attr.k)
+		}
+	}
+	if len(ignored) > 0 {
+		log.Printf("attributes of <component :is=%q> ignored: %s", is, strings.Join(ignored, " "))
+	}
+	if len(n.children) > 0 {
+		log.Println("children of <component> ignored:", is)
+	}
+	return is
+}
+
+// dynamicTag returns the :tag expression of an <element> and its other
+// attributes, ok is false for the other elements.
+func dynamicTag(tag string, attrSlice []attr) (string, []attr, bool) {
+	if tag != "element" {
+		return "", attrSlice, false
+	}
+	expr, ok := (&node{attrs: attrSlice}).attr(":tag")
+	if !ok || len(strings.TrimSpace(expr)) == 0 {
+		log.Fatalln("<element> without :tag")
+	}
+	if _, err := parser.ParseExpr(expr); err != nil {
+		log.Fatalf("invalid :tag=%q: %v", expr, err)
+	}
+	return expr, withoutAttr(attrSlice, ":tag"), true
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Handler is a component method bound to events.
+type Handler struct {
+	Name string
+	// Uses are the "@event on <tag>" bindings of the handler.
+	Uses []string
+}
+
+// generated are the methods and fields of the generated components.
+var generated = map[string]bool{
+	"Render":     true,
+	"Mount":      true,
+	"Unmount":    true,
+	"SkipRender": true,
+	"AddHead":    true,
+	"Core":       true,
+	"Compo":      true,
+	"dispatcher": true,
+	"setRef":     true,
+}
+
+// bind registers the method bound to an event of the tag element.
+func (c *Converter) bind(tag, event, method string) {
+	h, ok := c.Methods[method]
+	if !ok {
+		h = &Handler{Name: method}
+		c.Methods[method] = h
+	}
+	use := fmt.Sprintf("@%s on <%s>", event, tag)
+	for _, u := range h.Uses {
+		if u == use {
+			return
+		}
+	}
+	h.Uses = append(h.Uses, use)
+}
+
+// checkHandlers returns an error for the handlers clashing with the other
+// methods and fields of the component.
+func (c *Converter) checkHandlers() error {
+	names := []string{}
+	for name := range c.Methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		h := c.Methods[name]
+		if generated[name] {
+			return fmt.Errorf("handler %s of %s clashes with a generated method", name, strings.Join(h.Uses, ", "))
+		}
+		for _, r := range c.Refs {
+			if r == name {
+				return fmt.Errorf("handler %s of %s clashes with the ref field", name, strings.Join(h.Uses, ", "))
+			}
+		}
+		for _, hook := range c.Hooks {
+			if hook.Handler == name {
+				return fmt.Errorf("handler %s of %s is also the %s hook with another signature", name, strings.Join(h.Uses, ", "), hook.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// accessor is a method of the generated event types.
+type accessor struct {
+	name, result, expr string
+}
+
+// accessors maps the value kinds of the form elements to their event methods.
+var accessors = map[string][]accessor{
+	"Text": {
+		{"Value", "string", `e.Target.Get("value").String()`},
+	},
+	"Number": {
+		{"Value", "string", `e.Target.Get("value").String()`},
+		{"ValueAsFloat", "float64", `e.Target.Get("valueAsNumber").Float()`},
+		{"ValueAsInt", "int", `e.Target.Get("valueAsNumber").Int()`},
+	},
+	"Check": {
+		{"Value", "string", `e.Target.Get("value").String()`},
+		{"Checked", "bool", `e.Target.Get("checked").Bool()`},
+	},
+}
+
+// valueKind returns the kind of value the events of a form element carry,
+// "" for the elements without one.
+func valueKind(tag string, attrSlice []attr) string {
+	switch tag {
+	case "textarea", "select":
+		return "Text"
+	case "input":
+	default:
+		return ""
+	}
+	t := "text"
+	for _, attr := range attrSlice {
+		if attr.k == "type" {
+			t = strings.ToLower(attr.v)
+		}
+	}
+	if _, ok := inputTypes[t]; !ok {
+		return ""
+	}
+	switch t {
+	case "checkbox", "radio":
+		return "Check"
+	case "number", "range":
+		return "Number"
+	case "button", "submit", "reset", "image":
+		return ""
+	}
+	return "Text"
+}
+
+// eventType returns the name of the generated event type of a value kind.
+func (c *Converter) eventType(kind string) string {
+	c.Events[kind] = true
+	return c.Name + kind + "Event"
+}
+
+// eventTypes returns the declarations of the event types used by Render.
+func (c *Converter) eventTypes() []string {
+	kinds := []string{}
+	for kind := range c.Events {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	res := []string{}
+	for _, kind := range kinds {
+		t := c.Name + kind + "Event"
+		b := &strings.Builder{}
+		fmt.Fprintf(b, "// %s is the event of %s form elements.\n", t, strings.ToLower(kind))
+		fmt.Fprintf(b, "type %s struct {\n\t*vecty.Event\n}\n", t)
+		for _, a := range accessors[kind] {
+			fmt.Fprintf(b, "\n// %s ...\nfunc (e %s) %s() %s {\n\treturn %s\n}\n", a.name, t, a.name, a.result, a.expr)
+		}
+		res = append(res, b.String())
+	}
+	return res
+}
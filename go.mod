@@ -1,6 +1,6 @@
 module github.cojm/nobonobo/vectygen
 
-go 1.13
+go 1.16
 
 require (
 	github.com/gopherjs/vecty v0.0.0-20190701174234-2b6fc20f8913
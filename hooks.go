@@ -0,0 +1,173 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+// hook is a generated lifecycle method delegating to its handler.
+type hook struct {
+	Name      string
+	Signature string
+	Call      string
+	// Handler is the component method called, "" for a script function.
+	Handler string
+}
+
+// lifecycle maps the root attributes to the vecty lifecycle methods.
+var lifecycle = []struct {
+	attr      string
+	name      string
+	signature string
+	args      []string
+	result    bool
+}{
+	{"mount", "Mount", "Mount()", nil, false},
+	{"unmount", "Unmount", "Unmount()", nil, false},
+	{"skip-render", "SkipRender", "SkipRender(prev vecty.Component) bool", []string{"prev"}, true},
+}
+
+// globals maps the targets of the global event bindings to their js value.
+var globals = map[string]string{
+	"window":   "js.Global()",
+	"document": `js.Global().Get("document")`,
+}
+
+// script moves the imports of the go scripts to the generated imports and
+// returns the functions they declare without a receiver.
+func (c *Converter) script() (map[string]bool, error) {
+	funcs := map[string]bool{}
+	if len(c.AppendCode) == 0 {
+		return funcs, nil
+	}
+	src := "package p\n" + strings.Join(c.AppendCode, "\n")
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "script", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	for _, spec := range f.Imports {
+		p, _ := strconv.Unquote(spec.Path.Value)
+		modules := c.StdModules
+		if strings.Contains(strings.Split(p, "/")[0], ".") {
+			modules = c.ExtModules
+		}
+		if spec.Name != nil {
+			return nil, fmt.Errorf("script: named import of %q is not supported", p)
+		}
+		modules[p] = true
+	}
+	code := []string{}
+	for _, decl := range f.Decls {
+		if d, ok := decl.(*ast.GenDecl); ok && d.Tok == token.IMPORT {
+			continue
+		}
+		pos := decl.Pos()
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Recv == nil {
+				funcs[d.Name.Name] = true
+			}
+			if d.Doc != nil {
+				pos = d.Doc.Pos()
+			}
+		case *ast.GenDecl:
+			if d.Doc != nil {
+				pos = d.Doc.Pos()
+			}
+		}
+		code = append(code, src[fset.Position(pos).Offset:fset.Position(decl.End()).Offset])
+	}
+	c.AppendCode = code
+	return funcs, nil
+}
+
+// hooks removes the lifecycle attributes of the root element and adds the
+// methods calling their handler, a function of the go script is called with
+// the component. Mount and Unmount also bind the global events and render the
+// portals.
+func (c *Converter) hooks(root *node) error {
+	funcs, err := c.script()
+	if err != nil {
+		return err
+	}
+	n := rootElement(root)
+	if n == nil {
+		return nil
+	}
+	listeners := c.globalEvents(n)
+	names := map[string]string{}
+	for _, l := range lifecycle {
+		if name, ok := n.attr(l.attr); ok {
+			names[l.attr] = name
+			n.attrs = withoutAttr(n.attrs, l.attr)
+		}
+	}
+	portals, err := c.portals(root)
+	if err != nil {
+		return err
+	}
+	for k, v := range portals {
+		listeners[k] = append(listeners[k], v...)
+	}
+	for _, l := range lifecycle {
+		name, ok := names[l.attr]
+		if !ok {
+			if len(listeners[l.name]) > 0 {
+				c.Hooks = append(c.Hooks, hook{Name: l.name, Signature: l.signature, Call: strings.Join(listeners[l.name], "\n\t")})
+			}
+			continue
+		}
+		h := hook{Name: l.name, Signature: l.signature, Handler: name}
+		h.Call = fmt.Sprintf("c.%s(%s)", name, strings.Join(l.args, ", "))
+		if funcs[name] {
+			h.Handler = ""
+			h.Call = fmt.Sprintf("%s(%s)", name, strings.Join(append([]string{"c"}, l.args...), ", "))
+		}
+		if l.result {
+			h.Call = "return " + h.Call
+		}
+		switch l.name {
+		case "Mount":
+			h.Call = strings.Join(append(listeners[l.name], h.Call), "\n\t")
+		case "Unmount":
+			h.Call = strings.Join(append([]string{h.Call}, listeners[l.name]...), "\n\t")
+		}
+		c.Hooks = append(c.Hooks, h)
+	}
+	return nil
+}
+
+// globalEvents removes the @window:event and @document:event attributes of
+// the root element n and returns the Mount and Unmount statements adding and
+// removing their listeners.
+func (c *Converter) globalEvents(n *node) map[string][]string {
+	res := map[string][]string{}
+	attrSlice := []attr{}
+	for _, a := range n.attrs {
+		i := strings.Index(a.k, ":")
+		if !strings.HasPrefix(a.k, "@") || i < 0 || len(globals[a.k[1:i]]) == 0 {
+			attrSlice = append(attrSlice, a)
+			continue
+		}
+		target, value := a.k[1:i], globals[a.k[1:i]]
+		mods := strings.Split(a.k[i+1:], ".")
+		l, _ := c.modifiers(c.handler(n.tag, target+":"+mods[0], a.v, "e *vecty.Event", ""), mods[1:], true)
+		field := c.state(target+"Listener", "js.Func")
+		res["Mount"] = append(res["Mount"],
+			fmt.Sprintf("c.%s = js.FuncOf(func(this js.Value, args []js.Value) interface{} {\n\t\t%s(&vecty.Event{Value: args[0], Target: args[0].Get(\"target\")})\n\t\treturn nil\n\t})", field, l),
+			fmt.Sprintf("%s.Call(\"addEventListener\", %q, c.%s)", value, mods[0], field),
+		)
+		res["Unmount"] = append(res["Unmount"],
+			fmt.Sprintf("%s.Call(\"removeEventListener\", %q, c.%s)", value, mods[0], field),
+			fmt.Sprintf("c.%s.Release()", field),
+		)
+		c.StdModules["syscall/js"] = true
+	}
+	n.attrs = attrSlice
+	return res
+}
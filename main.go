@@ -14,6 +14,21 @@ var (
 	outputName    string
 	packageName   string
 	componentName string
+	keepComments  bool
+	document      bool
+	reverse       bool
+	prerender     bool
+	mappingName   string
+	scan          bool
+	configName    string
+	suffix        string
+	naming        string
+	handlers      string
+	strict        bool
+	target        string
+	backend       string
+	fragment      string
+	wasm          bool
 )
 
 func main() {
@@ -21,10 +36,39 @@ func main() {
 	flag.StringVar(&outputName, "o", "", "output filename")
 	flag.StringVar(&packageName, "p", "main", "output package name")
 	flag.StringVar(&componentName, "c", "", "component name")
+	flag.BoolVar(&keepComments, "comments", false, "keep html comments as go comments")
+	flag.BoolVar(&document, "document", false, "convert a full html document, <head> goes to AddHead")
+	flag.BoolVar(&reverse, "reverse", false, "convert the Render method of a go file back to html")
+	flag.BoolVar(&prerender, "html", false, "also generate RenderHTML for non-js builds")
+	flag.StringVar(&mappingName, "mapping", "", "json file overriding the element/property/event mapping")
+	flag.BoolVar(&scan, "scan", false, "read the mapping from the vecty packages required by go.mod")
+	flag.StringVar(&configName, "config", "", "project config file (default: nearest "+configFile+")")
+	flag.StringVar(&suffix, "suffix", "_gen.go", "output filename suffix")
+	flag.StringVar(&naming, "naming", "title", "component naming scheme: title or camel")
+	flag.StringVar(&handlers, "handlers", "dispatcher", "event handlers: dispatcher or methods")
+	flag.BoolVar(&strict, "strict", false, "fail on unknown elements and events")
+	flag.StringVar(&target, "target", "legacy", "vecty API: legacy (github.com/gopherjs/vecty) or current (github.com/hexops/vecty)")
+	flag.StringVar(&backend, "backend", "vecty", "output framework: vecty or go-app")
+	flag.StringVar(&fragment, "fragment", "div", "tag wrapping multiple root nodes, or list for a RenderList method")
+	flag.BoolVar(&wasm, "wasm", false, "restrict the output to js && wasm builds")
 	flag.Parse()
 	inputName := flag.Arg(0)
 	baseName := inputName[:len(inputName)-len(filepath.Ext(inputName))]
 	name := filepath.Base(baseName)
+	if len(configName) == 0 {
+		configName = findConfig(filepath.Dir(inputName))
+	}
+	cfg := &Config{}
+	if len(configName) > 0 {
+		c, err := LoadConfig(configName)
+		if err != nil {
+			log.Fatal(err)
+		}
+		cfg = c
+		if err := cfg.apply(flag.CommandLine); err != nil {
+			log.Fatal(err)
+		}
+	}
 	var input io.Reader = os.Stdin
 	if len(inputName) > 0 && inputName != "-" {
 		r, err := os.Open(inputName)
@@ -34,15 +78,57 @@ func main() {
 		defer r.Close()
 		input = r
 	}
+	vecty, ok := targets[target]
+	if !ok {
+		log.Fatalf("unknown target: %q", target)
+	}
+	mapping := DefaultMapping()
+	if scan {
+		if err := mapping.Scan(filepath.Dir(inputName), vecty); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if cfg.Mapping != nil {
+		mapping.merge(cfg.Mapping)
+	}
+	if len(mappingName) > 0 {
+		if err := mapping.LoadFile(mappingName); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if reverse {
+		var output io.Writer = os.Stdout
+		if len(outputName) > 0 {
+			f, err := os.Create(outputName)
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			output = f
+		}
+		r := NewReverser(mapping)
+		r.Components = cfg.Components
+		if err := r.Do(output, input, inputName); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 	if len(outputName) == 0 {
 		if len(inputName) > 0 {
-			outputName = baseName + "_gen.go"
+			outputName = baseName + suffix
 		} else {
 			outputName = "generated.go"
 		}
 	}
 	if len(componentName) == 0 {
-		componentName = strings.Title(name)
+		n, err := componentNameOf(name, naming)
+		if err != nil {
+			log.Fatal(err)
+		}
+		componentName = n
+	}
+	if handlers != "dispatcher" && handlers != "methods" {
+		log.Fatalf("unknown handlers mode: %q", handlers)
 	}
 	output, err := os.Create(outputName)
 	if err != nil {
@@ -52,18 +138,50 @@ func main() {
 
 	log.Printf("gen: %s -> %s", inputName, outputName)
 	converter := New()
+	converter.Name = componentName
+	converter.Mapping = mapping
+	converter.KeepComments = keepComments
+	converter.Document = document
+	converter.Strict = strict
+	converter.Fragment = fragment
+	converter.Handlers = handlers
+	converter.Vecty = vecty
+	converter.Prerender = prerender
+	converter.Backend, ok = backends[backend]
+	if !ok {
+		log.Fatalf("unknown backend: %q", backend)
+	}
+	if cfg.Components != nil {
+		converter.Components = cfg.Components
+	}
+	switch {
+	case wasm:
+		converter.BuildTag = "js && wasm"
+	case prerender:
+		// vecty can only be imported by js builds.
+		converter.BuildTag = "js"
+	}
 	buffer := bytes.NewBuffer(nil)
 	if err := converter.Do(buffer, input, packageName); err != nil {
 		log.Fatal(err)
 	}
-	if err := templ.Execute(output, map[string]interface{}{
-		"PkgName":       packageName,
-		"StdImports":    converter.StdModules,
-		"Imports":       converter.ExtModules,
-		"ComponentName": componentName,
-		"Generated":     buffer.String(),
-		"Methods":       converter.Methods,
-	}); err != nil {
+	if err := converter.Write(output, buffer.String(), packageName, componentName); err != nil {
+		log.Fatal(err)
+	}
+	if !prerender {
+		return
+	}
+	htmlName := strings.TrimSuffix(outputName, ".go") + "_html.go"
+	if strings.HasSuffix(outputName, suffix) {
+		htmlName = strings.TrimSuffix(outputName, suffix) + "_html" + suffix
+	}
+	htmlOutput, err := os.Create(htmlName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer htmlOutput.Close()
+	log.Printf("gen: %s -> %s", inputName, htmlName)
+	if err := converter.WriteHTML(htmlOutput, packageName, componentName); err != nil {
 		log.Fatal(err)
 	}
 }
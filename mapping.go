@@ -0,0 +1,251 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"sort"
+	"strings"
+)
+
+// propAttrs maps the dom property names to their html attribute.
+var propAttrs = map[string]string{
+	"className": "class",
+	"htmlFor":   "for",
+}
+
+// Mapping is the html element, property and event to go function tables.
+//
+// A function is written as "pkg.Func" for the vecty packages or as
+// "import/path.Func" for any other package.
+type Mapping struct {
+	Elements   map[string]string `json:"elements"`
+	Properties map[string]string `json:"properties"`
+	Booleans   map[string]bool   `json:"booleans"`
+	Events     map[string]string `json:"events"`
+}
+
+// DefaultMapping ...
+func DefaultMapping() *Mapping {
+	m := &Mapping{
+		Elements:   map[string]string{},
+		Properties: map[string]string{},
+		Booleans:   map[string]bool{},
+		Events:     map[string]string{},
+	}
+	for k, v := range elemNameMap {
+		m.Elements[k] = v
+	}
+	for k, v := range propMap {
+		m.Properties[k] = v
+	}
+	for k := range propBool {
+		m.Booleans[k] = true
+	}
+	for k, v := range eventTypes {
+		m.Events[k] = v
+	}
+	return m
+}
+
+// Load merges the json tables of r into m, an empty function removes the
+// mapping.
+func (m *Mapping) Load(r io.Reader) error {
+	var v Mapping
+	if err := json.NewDecoder(r).Decode(&v); err != nil {
+		return err
+	}
+	m.merge(&v)
+	return nil
+}
+
+// LoadFile ...
+func (m *Mapping) LoadFile(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := m.Load(f); err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	return nil
+}
+
+func (m *Mapping) merge(v *Mapping) {
+	for _, t := range []struct{ dst, src map[string]string }{
+		{m.Elements, v.Elements},
+		{m.Properties, v.Properties},
+		{m.Events, v.Events},
+	} {
+		for k, f := range t.src {
+			if len(f) == 0 {
+				delete(t.dst, k)
+				continue
+			}
+			t.dst[k] = f
+		}
+	}
+	for k, b := range v.Booleans {
+		if !b {
+			delete(m.Booleans, k)
+			continue
+		}
+		m.Booleans[k] = true
+	}
+}
+
+// Scan replaces the tables with the exported functions of the elem, event
+// and prop packages of vecty resolved by "go list" from dir, so the mapping
+// matches the vecty version required by the go.mod of dir.
+func (m *Mapping) Scan(dir, vecty string) error {
+	cmd := exec.Command("go", "list", "-f", "{{.Dir}}",
+		vecty+"/elem",
+		vecty+"/event",
+		vecty+"/prop",
+	)
+	cmd.Dir = dir
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("go list: %v", err)
+	}
+	v := &Mapping{
+		Elements:   map[string]string{},
+		Properties: map[string]string{},
+		Booleans:   map[string]bool{},
+		Events:     map[string]string{},
+	}
+	for _, d := range strings.Fields(string(out)) {
+		if err := v.scanDir(d); err != nil {
+			return err
+		}
+	}
+	if len(v.Elements) > 0 {
+		m.Elements = v.Elements
+	}
+	if len(v.Properties) > 0 {
+		m.Properties = v.Properties
+	}
+	if len(v.Events) > 0 {
+		m.Events = v.Events
+	}
+	for k := range v.Booleans {
+		m.Booleans[k] = true
+	}
+	return nil
+}
+
+// scanDir adds the functions of the package in dir returning vecty.Tag,
+// vecty.EventListener or vecty.Property values with a constant name.
+func (m *Mapping) scanDir(dir string) error {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return err
+	}
+	name := path.Base(dir)
+	if i := strings.Index(name, "@"); i >= 0 {
+		name = name[:i]
+	}
+	pkg, ok := pkgs[name]
+	if !ok {
+		return fmt.Errorf("%s: package %s not found", dir, name)
+	}
+	decls := []*ast.FuncDecl{}
+	for _, f := range pkg.Files {
+		for _, decl := range f.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Body != nil && fn.Name.IsExported() {
+				decls = append(decls, fn)
+			}
+		}
+	}
+	sort.Slice(decls, func(i, j int) bool {
+		return decls[i].Name.Name < decls[j].Name.Name
+	})
+	props := map[string]*ast.FuncDecl{}
+	for _, fn := range decls {
+		f := name + "." + fn.Name.Name
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.CompositeLit:
+				for _, elt := range n.Elts {
+					kv, ok := elt.(*ast.KeyValueExpr)
+					if !ok {
+						continue
+					}
+					if id, ok := kv.Key.(*ast.Ident); ok && id.Name == "Name" {
+						if s, ok := literal(kv.Value); ok {
+							m.Events[s] = f
+						}
+					}
+				}
+			case *ast.CallExpr:
+				sel, ok := n.Fun.(*ast.SelectorExpr)
+				if !ok || len(n.Args) < 2 {
+					return true
+				}
+				s, ok := literal(n.Args[0])
+				if !ok {
+					return true
+				}
+				switch sel.Sel.Name {
+				case "Tag":
+					m.Elements[s] = f
+				case "Property", "Attribute":
+					if a, ok := propAttrs[s]; ok {
+						s = a
+					}
+					// functions named after their attribute win over the others.
+					if prev, ok := props[s]; ok && strings.ToLower(prev.Name.Name) == s {
+						return false
+					}
+					props[s] = fn
+					m.Properties[s] = f
+					if p := fn.Type.Params.List; len(p) == 1 && fmt.Sprint(p[0].Type) == "bool" {
+						m.Booleans[s] = true
+					}
+				}
+				return false
+			}
+			return true
+		})
+	}
+	return nil
+}
+
+// qualify returns the "pkg.Func" expression of a mapped function and
+// registers its import.
+func (c *Converter) qualify(f string) string {
+	i := strings.LastIndex(f, ".")
+	if i < 0 {
+		return f
+	}
+	p, name := f[:i], f[i+1:]
+	switch {
+	case strings.Contains(p, "/"):
+		c.ExtModules[p] = true
+		return path.Base(p) + "." + name
+	case p == "vecty":
+	default:
+		c.ExtModules[c.Vecty+"/"+p] = true
+	}
+	return f
+}
+
+// shortName returns the "pkg.Func" form of a mapped function.
+func shortName(f string) string {
+	i := strings.LastIndex(f, ".")
+	if i < 0 {
+		return f
+	}
+	return path.Base(f[:i]) + f[i:]
+}
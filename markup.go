@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"log"
+	"strings"
+)
+
+// spreadMark is the place of the v-bind markup in the attrs results.
+const spreadMark = "\x00v-bind"
+
+// span is the markup written for the attribute k from res[start:].
+type span struct {
+	k     string
+	start int
+}
+
+// markupConds returns the conditions of the :attr.if attributes by the key of
+// their attribute.
+func markupConds(attrSlice []attr) map[string]string {
+	res := map[string]string{}
+	for _, attr := range attrSlice {
+		if !strings.HasPrefix(attr.k, ":") || !strings.HasSuffix(attr.k, ".if") {
+			continue
+		}
+		k := strings.TrimSuffix(attr.k[1:], ".if")
+		if !hasAttr(attrSlice, k) {
+			log.Fatalf("%s without a %s attribute", attr.k, k)
+		}
+		if k == "v-bind" {
+			log.Fatalln("v-bind can not be conditional, use a conditional expression")
+		}
+		if _, err := parser.ParseExpr(attr.v); err != nil {
+			log.Fatalf("invalid %s=%q: %v", attr.k, attr.v, err)
+		}
+		res[k] = attr.v
+	}
+	return res
+}
+
+// bound returns the go expression of the bound attribute k.
+func bound(k, v string) string {
+	if _, err := parser.ParseExpr(v); err != nil {
+		log.Fatalf("invalid %s=%q: %v", k, v, err)
+	}
+	return v
+}
+
+// markupIf wraps the markup of the conditional attributes of the spans into
+// vecty.MarkupIf.
+func markupIf(res []string, spans []span, conds map[string]string, indent int) []string {
+	if len(conds) == 0 {
+		return res
+	}
+	tab := strings.Repeat("\t", indent)
+	out := []string{}
+	for i, s := range spans {
+		end := len(res)
+		if i+1 < len(spans) {
+			end = spans[i+1].start
+		}
+		cond, ok := conds[s.k]
+		if !ok || s.start == end {
+			out = append(out, res[s.start:end]...)
+			continue
+		}
+		inner := strings.Replace(strings.Join(res[s.start:end], ""), "\n", "\n\t", -1)
+		out = append(out, fmt.Sprintf("\n%svecty.MarkupIf(%s,%s\n%s),", tab, cond, inner, tab))
+	}
+	return out
+}
+
+// spread adds the field of a v-bind="c.Field" spread, a wrapper component
+// forwards its markup property to the element.
+func (c *Converter) spread(v string) string {
+	v = strings.TrimSpace(v)
+	if _, err := parser.ParseExpr(v); err != nil {
+		log.Fatalf("invalid v-bind=%q: %v", v, err)
+	}
+	if name := strings.TrimPrefix(v, "c."); name != v && token.IsIdentifier(name) && token.IsExported(name) {
+		field := name + " []vecty.Applyer `vecty:\"prop\"`"
+		for _, s := range c.State {
+			if s == field {
+				return v
+			}
+		}
+		c.State = append(c.State, field)
+	}
+	return v
+}
+
+// markupList returns the vecty.Markup of res, the spread markup is spliced
+// in at its mark.
+func markupList(res []string, spread string, indent int) string {
+	tab := strings.Repeat("\t", indent)
+	at := -1
+	for i, s := range res {
+		if s == spreadMark {
+			at = i
+		}
+	}
+	if at < 0 {
+		return fmt.Sprintf("\n%svecty.Markup(%s\n%s),", tab, strings.Join(res, ""), tab)
+	}
+	if len(res) == 1 {
+		return fmt.Sprintf("\n%svecty.Markup(%s...),", tab, spread)
+	}
+	list := "[]vecty.Applyer{}"
+	if at > 0 {
+		list = fmt.Sprintf("[]vecty.Applyer{%s\n%s}", strings.Join(res[:at], ""), tab)
+	}
+	list = fmt.Sprintf("append(%s, %s...)", list, spread)
+	if at+1 < len(res) {
+		list = fmt.Sprintf("append(%s,%s\n%s)", list, strings.Join(res[at+1:], ""), tab)
+	}
+	return fmt.Sprintf("\n%svecty.Markup(%s...),", tab, list)
+}
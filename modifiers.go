@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// keyNames maps the key modifiers to their KeyboardEvent.key values, a
+// single character modifier matches that key.
+var keyNames = map[string]string{
+	"enter":     "Enter",
+	"esc":       "Escape",
+	"escape":    "Escape",
+	"tab":       "Tab",
+	"space":     " ",
+	"up":        "ArrowUp",
+	"down":      "ArrowDown",
+	"left":      "ArrowLeft",
+	"right":     "ArrowRight",
+	"delete":    "Delete",
+	"backspace": "Backspace",
+	"home":      "Home",
+	"end":       "End",
+	"pageup":    "PageUp",
+	"pagedown":  "PageDown",
+}
+
+// systemKeys maps the system modifiers to their event flags.
+var systemKeys = map[string]string{
+	"ctrl":  "ctrlKey",
+	"shift": "shiftKey",
+	"alt":   "altKey",
+	"meta":  "metaKey",
+}
+
+// listenerCalls maps the modifiers to the vecty.EventListener methods and
+// the event methods called by the key guards.
+var listenerCalls = map[string][2]string{
+	"prevent": {".PreventDefault()", "preventDefault"},
+	"stop":    {".StopPropagation()", "stopPropagation"},
+}
+
+// duration returns the go expression of d.
+func duration(d time.Duration) string {
+	switch {
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	case d%time.Millisecond == 0:
+		return fmt.Sprintf("%d * time.Millisecond", d/time.Millisecond)
+	}
+	return fmt.Sprintf("time.Duration(%d)", d)
+}
+
+// state adds a field keeping the state of a timing modifier.
+func (c *Converter) state(name, typ string) string {
+	field := fmt.Sprintf("%s%d", name, len(c.State))
+	c.State = append(c.State, field+" "+typ)
+	return field
+}
+
+// modifiers wraps l into the guards of the key modifiers and the state of the
+// timing modifiers, the calls are the listener methods of .prevent and .stop.
+// A guarded or inline listener calls them on the event itself.
+func (c *Converter) modifiers(l listener, mods []string, inline bool) (listener, string) {
+	if len(mods) == 0 {
+		return l, ""
+	}
+	conds := []string{}
+	calls := []string{}
+	body := l.body
+	ev := l.ev
+	for i := 0; i < len(mods); i++ {
+		mod := mods[i]
+		if _, ok := listenerCalls[mod]; ok {
+			calls = append(calls, mod)
+			continue
+		}
+		if key, ok := systemKeys[mod]; ok {
+			conds = append(conds, fmt.Sprintf("%s.Value.Get(%q).Bool()", ev, key))
+			continue
+		}
+		if key, ok := keyNames[mod]; ok {
+			conds = append(conds, fmt.Sprintf("%s.Value.Get(\"key\").String() == %q", ev, key))
+			continue
+		}
+		if len(mod) == 1 {
+			conds = append(conds, fmt.Sprintf("%s.Value.Get(\"key\").String() == %q", ev, mod))
+			continue
+		}
+		switch mod {
+		case "once":
+			field := c.state("once", "bool")
+			body = fmt.Sprintf("if c.%s { return }; c.%s = true; %s", field, field, body)
+			continue
+		case "debounce", "throttle":
+		default:
+			log.Fatalln("unknown event modifier:", mod)
+		}
+		if i+1 == len(mods) {
+			log.Fatalf("%s modifier without duration, use .%s.300ms", mod, mod)
+		}
+		i++
+		d, err := time.ParseDuration(mods[i])
+		if err != nil {
+			log.Fatalf("%s modifier: %v", mod, err)
+		}
+		c.StdModules["time"] = true
+		if mod == "debounce" {
+			field := c.state("debounce", "*time.Timer")
+			body = fmt.Sprintf("if c.%s != nil { c.%s.Stop() }; c.%s = time.AfterFunc(%s, func() { %s })", field, field, field, duration(d), body)
+		} else {
+			field := c.state("throttle", "time.Time")
+			body = fmt.Sprintf("if time.Since(c.%s) < %s { return }; c.%s = time.Now(); %s", field, duration(d), field, body)
+		}
+	}
+	methods := ""
+	if len(conds) > 0 || inline {
+		for i := len(calls) - 1; i >= 0; i-- {
+			body = fmt.Sprintf("%s.Value.Call(%q); %s", ev, listenerCalls[calls[i]][1], body)
+		}
+		if len(conds) > 0 {
+			body = fmt.Sprintf("if %s { %s }", strings.Join(conds, " && "), body)
+		}
+	} else {
+		for _, call := range calls {
+			methods += listenerCalls[call][0]
+		}
+	}
+	if body == l.body {
+		return l, methods
+	}
+	return listener{params: l.params, body: body, ev: ev}, methods
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"io"
+	"log"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+var voidTags = map[string]struct{}{
+	"area":   struct{}{},
+	"base":   struct{}{},
+	"br":     struct{}{},
+	"col":    struct{}{},
+	"embed":  struct{}{},
+	"hr":     struct{}{},
+	"img":    struct{}{},
+	"input":  struct{}{},
+	"link":   struct{}{},
+	"meta":   struct{}{},
+	"param":  struct{}{},
+	"source": struct{}{},
+	"track":  struct{}{},
+	"wbr":    struct{}{},
+}
+
+// node is a template node kept as written, without the html5 tree fixups.
+type node struct {
+	tag      string
+	attrs    []attr
+	text     string
+	comment  bool
+	parent   *node
+	children []*node
+}
+
+func (n *node) add(child *node) {
+	child.parent = n
+	n.children = append(n.children, child)
+}
+
+func (n *node) remove(child *node) {
+	for i, v := range n.children {
+		if v == child {
+			n.children = append(n.children[:i], n.children[i+1:]...)
+			child.parent = nil
+			return
+		}
+	}
+}
+
+// close closes the open elements from n up to (not including) end, void
+// elements without an end tag give their children back to the parent.
+func (n *node) close(end *node) {
+	for ; n != end; n = n.parent {
+		if _, ok := voidTags[n.tag]; !ok {
+			continue
+		}
+		children := n.children
+		n.children = nil
+		i := 0
+		for i < len(n.parent.children) && n.parent.children[i] != n {
+			i++
+		}
+		rest := append(children, n.parent.children[i+1:]...)
+		n.parent.children = append(n.parent.children[:i+1], rest...)
+		for _, child := range children {
+			child.parent = n.parent
+		}
+	}
+}
+
+func (n *node) isElement() bool {
+	return len(n.tag) > 0
+}
+
+func (n *node) isRaw() bool {
+	return n.tag == "raw" || hasAttr(n.attrs, "raw") || hasAttr(n.attrs, "v-html")
+}
+
+func (n *node) attr(key string) (string, bool) {
+	for _, attr := range n.attrs {
+		if attr.k == key {
+			return attr.v, true
+		}
+	}
+	return "", false
+}
+
+func (n *node) innerText() string {
+	if !n.isElement() {
+		if n.comment {
+			return ""
+		}
+		return n.text
+	}
+	res := []string{}
+	for _, child := range n.children {
+		res = append(res, child.innerText())
+	}
+	return strings.Join(res, "")
+}
+
+func isGoScript(attrSlice []attr) bool {
+	for _, attr := range attrSlice {
+		if attr.k == "type" && attr.v == "application/x-go" {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Converter) parse(r io.Reader) (*node, error) {
+	root := &node{}
+	cur := root
+	isGo := false
+	var raw *node
+	rawDepth := 0
+	z := html.NewTokenizer(r)
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			if err := z.Err(); err != io.EOF {
+				return nil, err
+			}
+			cur.close(root)
+			return root, nil
+		}
+		if raw != nil {
+			switch tt {
+			case html.StartTagToken:
+				if c.tag(z) == raw.tag {
+					rawDepth++
+				}
+			case html.EndTagToken:
+				if c.tag(z) == raw.tag {
+					rawDepth--
+				}
+			}
+			if rawDepth > 0 {
+				raw.text += string(z.Raw())
+			} else {
+				raw = nil
+			}
+			continue
+		}
+		switch tt {
+		case html.CommentToken:
+			cur.add(&node{text: string(z.Text()), comment: true})
+		case html.DoctypeToken:
+		case html.TextToken:
+			if isGo {
+				c.AppendCode = append(c.AppendCode, string(z.Text()))
+				continue
+			}
+			cur.add(&node{text: string(z.Text())})
+		case html.StartTagToken, html.SelfClosingTagToken:
+			attrSlice := parseAttrs(z)
+			tag := c.tag(z)
+			log.Println(tag, attrSlice)
+			if tag == "script" && tt == html.StartTagToken && isGoScript(attrSlice) {
+				isGo = true
+				continue
+			}
+			n := &node{tag: tag, attrs: attrSlice}
+			cur.add(n)
+			if tt == html.SelfClosingTagToken {
+				continue
+			}
+			if _, ok := voidTags[tag]; ok && n.isRaw() {
+				// a void element has no inner html nor end tag.
+				continue
+			}
+			if n.isRaw() {
+				raw, rawDepth = n, 1
+				continue
+			}
+			cur = n
+		case html.EndTagToken:
+			if isGo {
+				isGo = false
+				continue
+			}
+			tag := c.tag(z)
+			for n := cur; n != root; n = n.parent {
+				if n.tag == tag {
+					cur.close(n)
+					cur = n.parent
+					break
+				}
+			}
+		}
+	}
+}
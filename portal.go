@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// portal is a <portal target="selector"> element rendered by its own
+// component in place of the target.
+type portal struct {
+	Field  string
+	Method string
+	Target string
+	// Render is the generated element of the portal content.
+	Render string
+	node   *node
+}
+
+// idSelector matches the target selectors naming an id, the portal keeps
+// the id of the element it replaces.
+var idSelector = regexp.MustCompile(`^#[\w-]+$`)
+
+// portals removes the <portal target="selector"> elements from the tree into
+// c.Portals and returns the Mount and Unmount statements rendering them with
+// vecty.RenderInto, their content is rendered by Do.
+func (c *Converter) portals(root *node) (map[string][]string, error) {
+	res := map[string][]string{}
+	var walk func(n *node, guarded bool) error
+	walk = func(n *node, guarded bool) error {
+		if n.isElement() {
+			guarded = guarded || hasAttr(n.attrs, "v-if") || hasAttr(n.attrs, "v-for")
+		}
+		if n.tag == "portal" {
+			target, ok := n.attr("target")
+			switch {
+			case !ok || len(target) == 0:
+				return fmt.Errorf("<portal> without target")
+			case guarded:
+				return fmt.Errorf("<portal target=%q> under v-if or v-for, make its content conditional", target)
+			case hasAttr(n.attrs, "ref"):
+				return fmt.Errorf("<portal target=%q> can not have a ref", target)
+			}
+			i := len(c.Portals)
+			p := portal{Field: fmt.Sprintf("portal%d", i), Method: fmt.Sprintf("renderPortal%d", i), Target: target}
+			// RenderInto replaces the target by the rendered element of
+			// the same type.
+			p.node = &node{tag: "div", attrs: withoutAttr(n.attrs, "target")}
+			if idSelector.MatchString(target) && !hasAttr(n.attrs, "id") {
+				p.node.attrs = append(p.node.attrs, attr{k: "id", v: target[1:]})
+			}
+			for _, child := range n.children {
+				p.node.add(child)
+			}
+			n.parent.remove(n)
+			c.Portals = append(c.Portals, p)
+			res["Mount"] = append(res["Mount"], fmt.Sprintf("c.mountPortal(&c.%s, %q, c.%s)", p.Field, target, p.Method))
+			res["Unmount"] = append(res["Unmount"], fmt.Sprintf("c.%s.render = nil\n\tvecty.Rerender(c.%s)", p.Field, p.Field))
+			return walk(p.node, guarded)
+		}
+		for _, child := range append([]*node(nil), n.children...) {
+			if err := walk(child, guarded); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return res, walk(root, false)
+}
+
+// portalNodes returns the content elements of the portals.
+func (c *Converter) portalNodes() []*node {
+	nodes := []*node{}
+	for _, p := range c.Portals {
+		nodes = append(nodes, p.node)
+	}
+	return nodes
+}
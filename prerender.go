@@ -0,0 +1,208 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"path"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// htmlWriter collects the statements of RenderHTML.
+type htmlWriter struct {
+	parts   []string
+	buf     strings.Builder
+	imports map[string]bool
+}
+
+func (h *htmlWriter) static(s string) {
+	h.buf.WriteString(s)
+}
+
+// expr writes the string expression e.
+func (h *htmlWriter) expr(e string) {
+	h.flush()
+	h.parts = append(h.parts, checked(fmt.Sprintf("_, err := io.WriteString(w, %s)", e)))
+}
+
+// value writes the escaped value of a bound expression.
+func (h *htmlWriter) value(e string) {
+	h.imports["fmt"] = true
+	h.imports["html"] = true
+	h.expr(fmt.Sprintf("html.EscapeString(fmt.Sprint(%s))", e))
+}
+
+// component renders the component type t with its own RenderHTML.
+func (h *htmlWriter) component(t string, fields []string) {
+	h.flush()
+	if i := strings.LastIndex(t, "."); i >= 0 {
+		h.imports[t[:i]] = true
+		t = path.Base(t[:i]) + t[i:]
+	}
+	h.parts = append(h.parts, checked(fmt.Sprintf("err := (&%s{%s}).RenderHTML(w)", t, strings.Join(fields, ", "))))
+}
+
+// block writes the statements of body into the block of the head statement,
+// the head gets the statements to refer to the variables they use.
+func (h *htmlWriter) block(head func(body string) string, body func()) {
+	h.flush()
+	parts := h.parts
+	h.parts = nil
+	body()
+	h.flush()
+	inner := strings.Join(h.parts, "\n")
+	h.parts = append(parts, fmt.Sprintf("%s {\n\t%s\n}", head(inner), strings.Replace(inner, "\n", "\n\t", -1)))
+}
+
+func (h *htmlWriter) flush() {
+	if h.buf.Len() > 0 {
+		h.parts = append(h.parts, checked(fmt.Sprintf("_, err := io.WriteString(w, %q)", h.buf.String())))
+		h.buf.Reset()
+	}
+}
+
+// checked returns the statement returning the err of stmt.
+func checked(stmt string) string {
+	return fmt.Sprintf("if %s; err != nil {\n\treturn err\n}", stmt)
+}
+
+// ifHead returns the head of the if statement of cond.
+func ifHead(cond string) func(string) string {
+	return func(string) string { return "if " + cond }
+}
+
+// htmlAttrs writes the html attributes of the markup generated for attrSlice.
+func (c *Converter) htmlAttrs(h *htmlWriter, attrSlice []attr) {
+	conds := markupConds(attrSlice)
+	static, binding := "", ""
+	for _, attr := range attrSlice {
+		switch attr.k {
+		case "class":
+			static += " " + attr.v
+		case ":class":
+			binding = attr.v
+		}
+	}
+	switch {
+	case len(binding) > 0:
+		h.imports["html"] = true
+		h.imports["strings"] = true
+		h.static(` class="`)
+		h.expr(classNames(static, binding))
+		h.static(`"`)
+	case len(strings.Fields(static)) > 0:
+		h.static(fmt.Sprintf(" class=\"%s\"", html.EscapeString(strings.Join(strings.Fields(static), " "))))
+	}
+	for _, attr := range attrSlice {
+		k, v := attr.k, attr.v
+		write := func() {}
+		switch {
+		case k == "raw" || k == "v-html" || k == "v-bind" || k == "ref" || k == "class" || k == ":class" ||
+			k == "v-if" || k == "v-for" || strings.HasPrefix(k, "@") || strings.HasSuffix(k, ".if"):
+			continue
+		case len(c.Scope) > 0 && k == c.Scope:
+			write = func() { h.static(" " + k) }
+		case strings.HasPrefix(k, ":") && c.Mapping.Booleans[k[1:]]:
+			write = func() { h.block(ifHead(v), func() { h.static(" " + k[1:]) }) }
+		case strings.HasPrefix(k, ":"):
+			write = func() {
+				h.static(fmt.Sprintf(" %s=\"", k[1:]))
+				h.value(v)
+				h.static(`"`)
+			}
+		case c.Mapping.Booleans[k]:
+			if v == "false" {
+				continue
+			}
+			write = func() { h.static(" " + k) }
+		default:
+			write = func() { h.static(fmt.Sprintf(" %s=\"%s\"", k, html.EscapeString(v))) }
+		}
+		if cond, ok := conds[k]; ok {
+			h.block(ifHead(cond), write)
+		} else {
+			write()
+		}
+	}
+}
+
+func (c *Converter) prerender(h *htmlWriter, n *node) {
+	if n.tag == "component" {
+		log.Printf("<component :is=%q> is not prerendered", dynamicComponent(n))
+		return
+	}
+	if loop, ok := n.attr("v-for"); ok {
+		vars, expr := forClause(loop)
+		m := *n
+		m.attrs = withoutAttr(n.attrs, "v-for")
+		h.block(func(body string) string { return "for " + rangeClause(vars, expr, body) }, func() { c.prerender(h, &m) })
+		return
+	}
+	if cond, ok := n.attr("v-if"); ok {
+		m := *n
+		m.attrs = withoutAttr(n.attrs, "v-if")
+		h.block(ifHead(cond), func() { c.prerender(h, &m) })
+		return
+	}
+	switch {
+	case n.comment:
+	case !n.isElement():
+		h.static(html.EscapeString(strings.TrimSpace(n.text)))
+	case c.isSelf(n.tag):
+		h.component(c.Name, c.fields(n))
+	case len(c.Components[n.tag]) > 0:
+		h.component(c.Components[n.tag], c.fields(n))
+	default:
+		tag, attrSlice := n.tag, n.attrs
+		if n.isRaw() {
+			attrSlice = rawAttrs(n)
+			if tag == "raw" {
+				tag = "div"
+			}
+		}
+		if len(c.Scope) > 0 {
+			attrSlice = append(attrSlice[:len(attrSlice):len(attrSlice)], attr{k: c.Scope})
+		}
+		expr, attrSlice, dynamic := dynamicTag(tag, attrSlice)
+		name := func() {
+			if dynamic {
+				h.expr(expr)
+			} else {
+				h.static(tag)
+			}
+		}
+		h.static("<")
+		name()
+		c.htmlAttrs(h, attrSlice)
+		h.static(">")
+		if v, ok := n.attr("v-html"); ok {
+			h.expr(v)
+		} else if n.isRaw() {
+			h.static(strings.TrimSpace(n.text))
+		} else {
+			for _, child := range n.children {
+				c.prerender(h, child)
+			}
+		}
+		if _, ok := voidTags[tag]; ok && len(n.children) == 0 && len(n.text) == 0 {
+			return
+		}
+		h.static("</")
+		name()
+		h.static(">")
+	}
+}
+
+// html returns the statements of RenderHTML for the tree.
+func (c *Converter) html(root *node) []string {
+	h := &htmlWriter{imports: c.HTMLModules}
+	if len(c.Style) > 0 {
+		h.static("<style>" + c.Style + "</style>")
+	}
+	for _, n := range root.children {
+		c.prerender(h, n)
+	}
+	h.flush()
+	return h.parts
+}
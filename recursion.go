@@ -0,0 +1,244 @@
+package main
+
+import (
+	"fmt"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"log"
+	"strings"
+	"unicode"
+)
+
+// rootElement returns the first element of the tree, nil without one.
+func rootElement(root *node) *node {
+	for _, child := range root.children {
+		if child.isElement() {
+			return child
+		}
+	}
+	return nil
+}
+
+// props removes the props attribute of the root element and adds the prop
+// fields it declares, as in props="Node *Node, Depth int".
+func (c *Converter) props(root *node) error {
+	n := rootElement(root)
+	if n == nil {
+		return nil
+	}
+	v, ok := n.attr("props")
+	if !ok {
+		return nil
+	}
+	n.attrs = withoutAttr(n.attrs, "props")
+	for _, p := range splitTop(v, ',') {
+		p = strings.TrimSpace(p)
+		if len(p) == 0 {
+			continue
+		}
+		f := strings.SplitN(p, " ", 2)
+		if len(f) != 2 || !token.IsIdentifier(f[0]) || !token.IsExported(f[0]) {
+			return fmt.Errorf("invalid prop %q, use an exported name and its type", p)
+		}
+		if _, err := parser.ParseExpr(f[1]); err != nil {
+			return fmt.Errorf("invalid type of prop %s: %v", f[0], err)
+		}
+		field := f[0] + " " + strings.TrimSpace(f[1])
+		c.Props = append(c.Props, field)
+		if _, ok := c.Backend.(vectyBackend); ok {
+			field += " `vecty:\"prop\"`"
+		}
+		c.State = append(c.State, field)
+	}
+	return nil
+}
+
+// hasProp reports whether name is a prop field of the component.
+func (c *Converter) hasProp(name string) bool {
+	for _, p := range c.Props {
+		if strings.Fields(p)[0] == name {
+			return true
+		}
+	}
+	return false
+}
+
+// kebab returns the custom element name of a component name.
+func kebab(name string) string {
+	b := &strings.Builder{}
+	for i, r := range name {
+		if unicode.IsUpper(r) && i > 0 {
+			b.WriteByte('-')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// isSelf reports whether tag references the component itself, as <treenode>
+// or <tree-node> in TreeNode, the html elements keep their name.
+func (c *Converter) isSelf(tag string) bool {
+	if _, ok := c.Mapping.Elements[tag]; ok || tag == "raw" || tag == "component" || tag == "element" {
+		return false
+	}
+	return len(c.Name) > 0 && (tag == strings.ToLower(c.Name) || tag == kebab(c.Name))
+}
+
+// checkRecursion returns an error for the self references of the component
+// rendered whatever its state, they would render forever.
+func (c *Converter) checkRecursion(n *node, guarded bool) error {
+	if n.isElement() {
+		guarded = guarded || hasAttr(n.attrs, "v-if") || hasAttr(n.attrs, "v-for")
+		if c.isSelf(n.tag) && !guarded {
+			return fmt.Errorf("unbounded recursion: <%s> renders itself without a v-if or v-for guard", n.tag)
+		}
+	}
+	for _, child := range n.children {
+		if err := c.checkRecursion(child, guarded); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loopState are the event modifiers keeping their state in a component field.
+var loopState = map[string]bool{"once": true, "debounce": true, "throttle": true}
+
+// checkLoops returns an error for the refs and the stateful modifiers under a
+// v-for, the elements of every iteration would share their single field.
+func (c *Converter) checkLoops(n *node, looped bool) error {
+	if n.isElement() {
+		looped = looped || hasAttr(n.attrs, "v-for")
+	}
+	if looped {
+		for _, attr := range n.attrs {
+			if attr.k == "ref" {
+				return fmt.Errorf("ref=%q of <%s> under v-for, every iteration would set it", attr.v, n.tag)
+			}
+			if !strings.HasPrefix(attr.k, "@") {
+				continue
+			}
+			for _, mod := range strings.Split(attr.k, ".")[1:] {
+				if loopState[mod] {
+					return fmt.Errorf("%s of <%s> under v-for, the iterations would share its state", attr.k, n.tag)
+				}
+			}
+		}
+	}
+	for _, child := range n.children {
+		if err := c.checkLoops(child, looped); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// guard wraps the expression e of n into its v-for loop and v-if condition.
+func (c *Converter) guard(n *node, e string, indent int) string {
+	cond, hasIf := n.attr("v-if")
+	loop, hasFor := n.attr("v-for")
+	if !hasIf && !hasFor {
+		return e
+	}
+	if _, ok := c.Backend.(vectyBackend); !ok {
+		log.Fatalln("v-if and v-for are supported by the vecty backend only")
+	}
+	if n.parent == nil || !n.parent.isElement() {
+		log.Fatalf("v-if and v-for of <%s> need a parent element", n.tag)
+	}
+	if hasIf {
+		if _, err := parser.ParseExpr(cond); err != nil {
+			log.Fatalf("invalid v-if=%q: %v", cond, err)
+		}
+	}
+	tab := strings.Repeat("\t", indent)
+	if !hasFor {
+		// vecty.If would build the child whatever the condition.
+		return fmt.Sprintf("func() vecty.MarkupOrChild {\n%s\tif %s {\n%s\t\treturn %s\n%s\t}\n%s\treturn nil\n%s}()",
+			tab, cond, tab, strings.Replace(e, "\n", "\n\t\t", -1), tab, tab, tab)
+	}
+	vars, expr := forClause(loop)
+	add := fmt.Sprintf("l = append(l, %s)", strings.Replace(e, "\n", "\n\t\t", -1))
+	if hasIf {
+		add = fmt.Sprintf("if %s {\n%s\t\t\t%s\n%s\t\t}", cond, tab, strings.Replace(add, "\n", "\n\t", -1), tab)
+	}
+	clause := rangeClause(vars, expr, add)
+	// the loop variables are copied for the closures of the event handlers.
+	copies := ""
+	for _, v := range usedVars(vars, add) {
+		if v != "_" {
+			copies += fmt.Sprintf("\n%s\t\t%s := %s", tab, v, v)
+		}
+	}
+	return fmt.Sprintf("func() vecty.List {\n%s\tl := vecty.List{}\n%s\tfor %s {%s\n%s\t\t%s\n%s\t}\n%s\treturn l\n%s}()",
+		tab, tab, clause, copies, tab, add, tab, tab, tab)
+}
+
+// forClause returns the loop variables and the ranged expression of a v-for,
+// as in "child in c.Node.Children" or "i, child in c.Items".
+func forClause(v string) ([]string, string) {
+	f := strings.SplitN(v, " in ", 2)
+	if len(f) != 2 {
+		log.Fatalf("invalid v-for=%q, use \"item in expr\"", v)
+	}
+	vars := []string{}
+	for _, s := range strings.Split(f[0], ",") {
+		s = strings.TrimSpace(s)
+		if !token.IsIdentifier(s) && s != "_" {
+			log.Fatalf("invalid v-for=%q: %q is not a variable", v, s)
+		}
+		vars = append(vars, s)
+	}
+	switch len(vars) {
+	case 1:
+		vars = append([]string{"_"}, vars...)
+	case 2:
+	default:
+		log.Fatalf("invalid v-for=%q, use \"item in expr\" or \"i, item in expr\"", v)
+	}
+	expr := strings.TrimSpace(f[1])
+	if _, err := parser.ParseExpr(expr); err != nil {
+		log.Fatalf("invalid v-for=%q: %v", v, err)
+	}
+	return vars, expr
+}
+
+// usedVars returns the loop variables of a v-for, the variables the loop body
+// does not use are replaced by _.
+func usedVars(vars []string, body string) []string {
+	idents := map[string]bool{}
+	var s scanner.Scanner
+	fset := token.NewFileSet()
+	s.Init(fset.AddFile("", fset.Base(), len(body)), []byte(body), nil, 0)
+	for {
+		_, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok == token.IDENT {
+			idents[lit] = true
+		}
+	}
+	used := []string{}
+	for _, v := range vars {
+		if !idents[v] {
+			v = "_"
+		}
+		used = append(used, v)
+	}
+	return used
+}
+
+// rangeClause returns the range clause of a v-for loop over the variables the
+// loop body uses.
+func rangeClause(vars []string, expr, body string) string {
+	used := usedVars(vars, body)
+	switch {
+	case used[1] != "_":
+		return fmt.Sprintf("%s, %s := range %s", used[0], used[1], expr)
+	case used[0] != "_":
+		return fmt.Sprintf("%s := range %s", used[0], expr)
+	}
+	return "range " + expr
+}
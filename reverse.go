@@ -0,0 +1,599 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"html"
+	"io"
+	"log"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Reverser converts the Render methods of vecty components back to html.
+type Reverser struct {
+	// Components maps the tags of the registered components to their type.
+	Components map[string]string
+
+	fset   *token.FileSet
+	pkgs   map[string]string
+	elems  map[string]string
+	props  map[string]string
+	events map[string]string
+	recv   string
+
+	// dispatched are the generated methods calling the dispatcher funcs.
+	dispatched map[string]bool
+	dispatcher bool
+}
+
+// NewReverser ...
+func NewReverser(m *Mapping) *Reverser {
+	r := &Reverser{
+		fset:       token.NewFileSet(),
+		pkgs:       map[string]string{},
+		elems:      map[string]string{},
+		props:      map[string]string{},
+		events:     map[string]string{},
+		dispatched: map[string]bool{},
+	}
+	for k, v := range m.Elements {
+		r.elems[shortName(v)] = k
+	}
+	for k, v := range m.Properties {
+		r.props[shortName(v)] = k
+	}
+	for k, v := range m.Events {
+		r.events[shortName(v)] = k
+	}
+	return r
+}
+
+// Do ...
+func (r *Reverser) Do(output io.Writer, input io.Reader, filename string) error {
+	f, err := parser.ParseFile(r.fset, filename, input, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	for _, spec := range f.Imports {
+		p, _ := strconv.Unquote(spec.Path.Value)
+		name := path.Base(p)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		r.pkgs[name] = path.Base(p)
+	}
+	renders := []*ast.FuncDecl{}
+	// the RenderList method of a list fragment holds the root nodes.
+	lists := map[string]*ast.FuncDecl{}
+	// portals are the types rendering a <portal>, not reversed.
+	portals := map[string]bool{}
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || fn.Body == nil {
+			continue
+		}
+		if strings.Contains(r.source(fn.Body), ".dispatcher[") {
+			r.dispatched[fn.Name.Name] = true
+		}
+		switch fn.Name.Name {
+		case "Render":
+			renders = append(renders, fn)
+		case "RenderList":
+			lists[r.source(fn.Recv.List[0].Type)] = fn
+		case "mountPortal":
+			r.warn(fn.Name, "<portal> content not converted")
+			portals[strings.TrimPrefix(r.source(fn.Type.Params.List[0].Type), "*")] = true
+		}
+	}
+	for i := 0; i < len(renders); i++ {
+		if portals[r.source(renders[i].Recv.List[0].Type)] {
+			renders = append(renders[:i], renders[i+1:]...)
+			i--
+		}
+	}
+	ast.Inspect(f, func(n ast.Node) bool {
+		if field, ok := n.(*ast.Field); ok && len(field.Names) == 1 && field.Names[0].Name == "dispatcher" {
+			r.dispatcher = true
+		}
+		return !r.dispatcher
+	})
+	for i, fn := range renders {
+		if list, ok := lists[r.source(fn.Recv.List[0].Type)]; ok {
+			renders[i] = list
+		}
+	}
+	if len(renders) == 0 {
+		return fmt.Errorf("%s: no Render method found", filename)
+	}
+	for _, fn := range renders {
+		var ret *ast.ReturnStmt
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if _, ok := n.(*ast.FuncLit); ok {
+				return false
+			}
+			if s, ok := n.(*ast.ReturnStmt); ok && len(s.Results) == 1 {
+				ret = s
+			}
+			return true
+		})
+		if ret == nil {
+			return fmt.Errorf("%s: Render without return value", filename)
+		}
+		r.recv = "c"
+		if names := fn.Recv.List[0].Names; len(names) > 0 {
+			r.recv = names[0].Name
+		}
+		if len(renders) > 1 {
+			fmt.Fprintf(output, "<!-- %s -->\n", r.source(fn.Recv.List[0].Type))
+		}
+		r.child(output, ret.Results[0], 0, nil)
+	}
+	return nil
+}
+
+func (r *Reverser) source(expr ast.Node) string {
+	b := bytes.NewBuffer(nil)
+	printer.Fprint(b, r.fset, expr)
+	return b.String()
+}
+
+func (r *Reverser) warn(expr ast.Node, msg string) {
+	log.Printf("%s: %s: %s", r.fset.Position(expr.Pos()), msg, r.source(expr))
+}
+
+// call returns the "pkg.Func" name of a call with the import alias resolved.
+func (r *Reverser) call(expr ast.Expr) (string, *ast.CallExpr) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return "", nil
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", call
+	}
+	if id, ok := sel.X.(*ast.Ident); ok {
+		if pkg, ok := r.pkgs[id.Name]; ok {
+			return pkg + "." + sel.Sel.Name, call
+		}
+	}
+	return "", call
+}
+
+// listenerMods maps the chained vecty.EventListener methods to their event
+// modifiers.
+var listenerMods = map[string]string{
+	"PreventDefault":  ".prevent",
+	"StopPropagation": ".stop",
+}
+
+// chain returns the listener of event.Click(f).PreventDefault() like calls
+// and the modifiers of its chained methods.
+func (r *Reverser) chain(expr ast.Expr) (ast.Expr, string) {
+	mods := ""
+	for {
+		if p, ok := expr.(*ast.ParenExpr); ok {
+			expr = p.X
+			continue
+		}
+		call, ok := expr.(*ast.CallExpr)
+		if !ok || len(call.Args) > 0 {
+			return expr, mods
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || len(listenerMods[sel.Sel.Name]) == 0 {
+			return expr, mods
+		}
+		mods = listenerMods[sel.Sel.Name] + mods
+		expr = sel.X
+	}
+}
+
+func literal(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
+
+// isBoolean reports whether expr is the true or false identifier, the
+// strings "true" and "false" are attribute values.
+func isBoolean(expr ast.Expr) bool {
+	id, ok := expr.(*ast.Ident)
+	return ok && (id.Name == "true" || id.Name == "false")
+}
+
+type element struct {
+	tag     string
+	attrs   []attr
+	classes []string
+	// classAt is 1 + the index of the class attribute in attrs, 0 before the
+	// first class.
+	classAt  int
+	styles   []string
+	raw      string
+	children []ast.Expr
+}
+
+func (e *element) set(k, v string) {
+	e.attrs = append(e.attrs, attr{k: k, v: v})
+}
+
+// class adds classes, the class attribute keeps the place of the first one.
+func (e *element) class(names ...string) {
+	if e.classAt == 0 {
+		e.classAt = len(e.attrs) + 1
+	}
+	e.classes = append(e.classes, names...)
+}
+
+// lazyIf returns the condition and the child of the func literal calls of
+// v-if, func() vecty.MarkupOrChild { if cond { return e }; return nil }().
+func (r *Reverser) lazyIf(expr ast.Expr) (string, ast.Expr, bool) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) > 0 {
+		return "", nil, false
+	}
+	fn, ok := call.Fun.(*ast.FuncLit)
+	if !ok || len(fn.Body.List) != 2 {
+		return "", nil, false
+	}
+	s, ok := fn.Body.List[0].(*ast.IfStmt)
+	if !ok || s.Init != nil || s.Else != nil || len(s.Body.List) != 1 {
+		return "", nil, false
+	}
+	ret, ok := s.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return "", nil, false
+	}
+	return r.source(s.Cond), ret.Results[0], true
+}
+
+// child writes the html of a child expression, attrs are the attributes of
+// its wrappers like setRef.
+func (r *Reverser) child(w io.Writer, expr ast.Expr, indent int, attrs []attr) {
+	tab := strings.Repeat("  ", indent)
+	if cond, e, ok := r.lazyIf(expr); ok {
+		r.child(w, e, indent, append(attrs, attr{k: "v-if", v: cond}))
+		return
+	}
+	if u, ok := expr.(*ast.UnaryExpr); ok && u.Op == token.AND {
+		if lit, ok := u.X.(*ast.CompositeLit); ok {
+			r.component(w, lit, indent, attrs)
+			return
+		}
+	}
+	name, call := r.call(expr)
+	switch {
+	case name == "vecty.Text" && len(call.Args) > 0:
+		if s, ok := literal(call.Args[0]); ok {
+			fmt.Fprintf(w, "%s%s\n", tab, html.EscapeString(s))
+			return
+		}
+	case name == "vecty.Tag" && len(call.Args) > 0:
+		e := &element{tag: "element", attrs: attrs}
+		if tag, ok := literal(call.Args[0]); ok {
+			e.tag = tag
+		} else {
+			e.set(":tag", r.source(call.Args[0]))
+		}
+		r.element(w, e, call.Args[1:], indent)
+		return
+	case name == "vecty.If" && len(call.Args) == 2:
+		r.child(w, call.Args[1], indent, append(attrs, attr{k: "v-if", v: r.source(call.Args[0])}))
+		return
+	case len(r.elems[name]) > 0 || strings.HasPrefix(name, "elem."):
+		tag, ok := r.elems[name]
+		if !ok {
+			tag = strings.ToLower(strings.TrimPrefix(name, "elem."))
+		}
+		r.element(w, &element{tag: tag, attrs: attrs}, call.Args, indent)
+		return
+	case call != nil && r.source(call.Fun) == r.recv+".setRef" && len(call.Args) == 2:
+		if u, ok := call.Args[0].(*ast.UnaryExpr); ok && u.Op == token.AND {
+			ref := strings.TrimPrefix(r.source(u.X), r.recv+".")
+			r.child(w, call.Args[1], indent, append(attrs, attr{k: "ref", v: ref}))
+			return
+		}
+	}
+	if list, ok := expr.(*ast.CompositeLit); ok && r.source(list.Type) == "vecty.List" {
+		for _, v := range list.Elts {
+			r.child(w, v, indent, nil)
+		}
+		return
+	}
+	guarded := true
+	for _, a := range attrs {
+		guarded = guarded && (a.k == "v-if" || a.k == "v-for")
+	}
+	if guarded && call != nil {
+		// a component or markup expression of the component state.
+		r.element(w, &element{tag: "component", attrs: append([]attr{{k: ":is", v: r.source(expr)}}, attrs...)}, nil, indent)
+		return
+	}
+	r.warn(expr, "not convertible")
+	fmt.Fprintf(w, "%s<!-- %s -->\n", tab, strings.Replace(r.source(expr), "--", "- -", -1))
+}
+
+func (r *Reverser) element(w io.Writer, e *element, args []ast.Expr, indent int) {
+	tab := strings.Repeat("  ", indent)
+	for _, arg := range args {
+		if name, call := r.call(arg); name == "vecty.Markup" {
+			r.markups(e, call.Args, call.Ellipsis.IsValid())
+			continue
+		}
+		e.children = append(e.children, arg)
+	}
+	attrSlice := []attr{}
+	if len(e.styles) > 0 {
+		attrSlice = append(attrSlice, attr{k: "style", v: strings.Join(e.styles, " ")})
+	}
+	attrSlice = append(attrSlice, e.attrs...)
+	if len(e.classes) > 0 {
+		i := len(attrSlice) - len(e.attrs) + e.classAt - 1
+		class := attr{k: "class", v: strings.Join(e.classes, " ")}
+		attrSlice = append(attrSlice[:i], append([]attr{class}, attrSlice[i:]...)...)
+	}
+	a := ""
+	for _, attr := range attrSlice {
+		if attr.v == "" {
+			a += " " + attr.k
+		} else {
+			a += fmt.Sprintf(" %s=\"%s\"", attr.k, html.EscapeString(attr.v))
+		}
+	}
+	if len(e.raw) > 0 {
+		fmt.Fprintf(w, "%s<%s raw%s>%s</%s>\n", tab, e.tag, a, e.raw, e.tag)
+		return
+	}
+	if len(e.children) == 0 {
+		if _, ok := voidTags[e.tag]; ok {
+			fmt.Fprintf(w, "%s<%s%s />\n", tab, e.tag, a)
+		} else {
+			fmt.Fprintf(w, "%s<%s%s></%s>\n", tab, e.tag, a, e.tag)
+		}
+		return
+	}
+	fmt.Fprintf(w, "%s<%s%s>\n", tab, e.tag, a)
+	for _, child := range e.children {
+		r.child(w, child, indent+1, nil)
+	}
+	fmt.Fprintf(w, "%s</%s>\n", tab, e.tag)
+}
+
+// component writes the element of a &Type{Field: value} component, the
+// string fields are attributes and the others are bound.
+func (r *Reverser) component(w io.Writer, lit *ast.CompositeLit, indent int, attrs []attr) {
+	t := r.source(lit.Type)
+	tag := t
+	if i := strings.LastIndex(t, "."); i >= 0 {
+		tag = t[i+1:]
+	}
+	tag = kebab(tag)
+	for k, v := range r.Components {
+		if shortName(v) == t {
+			tag = k
+		}
+	}
+	e := &element{tag: tag, attrs: attrs}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			r.warn(elt, "field dropped")
+			continue
+		}
+		k := r.source(kv.Key)
+		switch s, ok := literal(kv.Value); {
+		case k == "dispatcher":
+		case ok:
+			e.set(kebab(k), s)
+		default:
+			e.set(":"+kebab(k), r.source(kv.Value))
+		}
+	}
+	r.element(w, e, nil, indent)
+}
+
+// markups sets the attributes of the markup arguments, the last one of a
+// spread call is the markup of v-bind or an append of markups.
+func (r *Reverser) markups(e *element, args []ast.Expr, spread bool) {
+	for i, arg := range args {
+		if !spread || i+1 < len(args) {
+			r.markup(e, arg)
+			continue
+		}
+		switch a := arg.(type) {
+		case *ast.CallExpr:
+			if id, ok := a.Fun.(*ast.Ident); ok && id.Name == "append" && len(a.Args) > 0 {
+				r.markups(e, a.Args[:1], true)
+				r.markups(e, a.Args[1:], a.Ellipsis.IsValid())
+				continue
+			}
+		case *ast.CompositeLit:
+			if r.source(a.Type) == "[]vecty.Applyer" {
+				r.markups(e, a.Elts, false)
+				continue
+			}
+		}
+		e.set("v-bind", r.source(arg))
+	}
+}
+
+// handler returns the attribute value of an event listener.
+func (r *Reverser) handler(expr ast.Expr) string {
+	fn, ok := expr.(*ast.FuncLit)
+	if !ok {
+		name := strings.TrimPrefix(r.source(expr), r.recv+".")
+		if r.dispatcher && !r.dispatched[name] && token.IsIdentifier(name) {
+			// the own method of a component with a dispatcher.
+			return "c." + name
+		}
+		return name
+	}
+	// inline handlers keep their statements.
+	stmts := []string{}
+	for i, s := range fn.Body.List {
+		if i == 0 && strings.HasPrefix(r.source(s), "e := ") && strings.HasSuffix(r.source(s), "Event{ev}") {
+			// the binding of the generated event type.
+			continue
+		}
+		stmts = append(stmts, r.source(s))
+	}
+	return strings.Join(stmts, "; ")
+}
+
+// listener sets the event of a &vecty.EventListener{Name, Listener} literal.
+func (r *Reverser) listener(e *element, expr ast.Expr, mods string) bool {
+	u, ok := expr.(*ast.UnaryExpr)
+	if !ok || u.Op != token.AND {
+		return false
+	}
+	lit, ok := u.X.(*ast.CompositeLit)
+	if !ok || r.source(lit.Type) != "vecty.EventListener" {
+		return false
+	}
+	name, handler := "", ""
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		switch r.source(kv.Key) {
+		case "Name":
+			name, _ = literal(kv.Value)
+		case "Listener":
+			handler = r.handler(kv.Value)
+		}
+	}
+	if len(name) == 0 || len(handler) == 0 {
+		r.warn(expr, "event listener dropped")
+		return true
+	}
+	e.set("@"+name+mods, handler)
+	return true
+}
+
+func (r *Reverser) markup(e *element, expr ast.Expr) {
+	expr, mods := r.chain(expr)
+	if r.listener(e, expr, mods) {
+		return
+	}
+	if list, ok := expr.(*ast.CompositeLit); ok && r.source(list.Type) == "vecty.ClassMap" {
+		// the dynamic classes go to the :class binding.
+		names, conds := []string{}, []string{}
+		for _, elt := range list.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			k, ok := literal(kv.Key)
+			v := r.source(kv.Value)
+			switch {
+			case ok && v == "true":
+				e.class(k)
+			case ok && token.IsIdentifier(k):
+				conds = append(conds, k+": "+v)
+			case ok:
+				conds = append(conds, "'"+k+"': "+v)
+			case v == "true":
+				names = append(names, r.source(kv.Key))
+			default:
+				r.warn(elt, "dynamic class dropped")
+			}
+		}
+		switch {
+		case len(names) > 0:
+			if len(conds) > 0 {
+				names = append(names, "{"+strings.Join(conds, ", ")+"}")
+			}
+			e.set(":class", "["+strings.Join(names, ", ")+"]")
+		case len(conds) > 0:
+			e.set(":class", "{"+strings.Join(conds, ", ")+"}")
+		}
+		return
+	}
+	name, call := r.call(expr)
+	if call == nil {
+		r.warn(expr, "markup dropped")
+		return
+	}
+	args := []string{}
+	static := true
+	for _, arg := range call.Args {
+		s, ok := literal(arg)
+		if !ok {
+			s, static = r.source(arg), false
+		}
+		args = append(args, s)
+	}
+	switch {
+	case name == "vecty.Markup":
+		r.markups(e, call.Args, call.Ellipsis.IsValid())
+	case name == "vecty.MarkupIf" && len(call.Args) > 1:
+		// the attributes set by the markup get their :attr.if condition.
+		n, classes := len(e.attrs), len(e.classes)
+		r.markups(e, call.Args[1:], call.Ellipsis.IsValid())
+		for _, a := range e.attrs[n:len(e.attrs):len(e.attrs)] {
+			e.set(":"+a.k+".if", r.source(call.Args[0]))
+		}
+		if len(e.classes) > classes {
+			r.warn(expr, "condition of the classes dropped")
+		}
+	case (name == "vecty.Property" || name == "vecty.Attribute") && len(args) == 2 && isBoolean(call.Args[1]):
+		// the boolean properties like disabled.
+		if k, ok := literal(call.Args[0]); ok && args[1] == "true" {
+			e.set(k, "")
+		} else if !ok {
+			r.warn(expr, "dynamic property dropped")
+		}
+	case name == "vecty.Class" && static:
+		e.class(args...)
+	case name == "vecty.Style" && static && len(args) == 2:
+		e.styles = append(e.styles, fmt.Sprintf("%s: %s;", args[0], args[1]))
+	case (name == "vecty.Property" || name == "vecty.Attribute") && static && len(args) == 2:
+		e.set(args[0], args[1])
+	case (name == "vecty.Property" || name == "vecty.Attribute") && len(args) == 2:
+		if k, ok := literal(call.Args[0]); ok {
+			e.set(":"+k, args[1])
+		} else {
+			r.warn(expr, "dynamic property dropped")
+		}
+	case name == "vecty.Data" && static && len(args) == 2:
+		e.set("data-"+args[0], args[1])
+	case name == "vecty.UnsafeHTML" && len(args) == 1:
+		if static {
+			e.raw = args[0]
+		} else {
+			e.set("v-html", args[0])
+		}
+	case (len(r.props[name]) > 0 || strings.HasPrefix(name, "prop.")) && len(args) == 1:
+		k, ok := r.props[name]
+		if !ok {
+			k = strings.ToLower(strings.TrimPrefix(name, "prop."))
+		}
+		switch {
+		case isBoolean(call.Args[0]) && args[0] == "true":
+			e.set(k, "")
+		case isBoolean(call.Args[0]):
+		case static:
+			e.set(k, args[0])
+		default:
+			e.set(":"+k, args[0])
+		}
+	case (len(r.events[name]) > 0 || strings.HasPrefix(name, "event.")) && len(args) == 1:
+		k, ok := r.events[name]
+		if !ok {
+			k = strings.ToLower(strings.TrimPrefix(name, "event."))
+		}
+		e.set("@"+k+mods, r.handler(call.Args[0]))
+	default:
+		r.warn(expr, "markup dropped")
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
+)
+
+var cssComment = regexp.MustCompile(`(?s)/\*.*?\*/`)
+
+// scoped moves the <style scoped> blocks out of the render tree, every element
+// gets the scope attribute and the selectors are rewritten to match it.
+func (c *Converter) scoped(root *node) {
+	styles := []string{}
+	var walk func(n *node)
+	walk = func(n *node) {
+		for _, child := range append([]*node{}, n.children...) {
+			if _, ok := child.attr("scoped"); ok && child.tag == "style" {
+				styles = append(styles, child.innerText())
+				n.remove(child)
+				continue
+			}
+			walk(child)
+		}
+	}
+	walk(root)
+	if len(styles) == 0 {
+		return
+	}
+	css := strings.TrimSpace(cssComment.ReplaceAllString(strings.Join(styles, "\n"), ""))
+	h := fnv.New32a()
+	h.Write([]byte(css))
+	c.Scope = fmt.Sprintf("data-v-%08x", h.Sum32())
+	c.Style = scopeCSS(css, c.Scope)
+	c.StdModules["syscall/js"] = true
+}
+
+// scopeCSS adds the [scope] attribute selector to every rule of css.
+func scopeCSS(css, scope string) string {
+	b := strings.Builder{}
+	for len(css) > 0 {
+		i := strings.IndexAny(css, "{}")
+		if i < 0 {
+			b.WriteString(css)
+			break
+		}
+		if css[i] == '}' {
+			b.WriteString(css[:i+1])
+			css = css[i+1:]
+			continue
+		}
+		prelude := css[:i]
+		if j := strings.LastIndex(prelude, ";"); j >= 0 {
+			// statements like @import before the rule.
+			b.WriteString(prelude[:j+1])
+			prelude = prelude[j+1:]
+		}
+		end, depth := i+1, 1
+		for ; end < len(css) && depth > 0; end++ {
+			switch css[end] {
+			case '{':
+				depth++
+			case '}':
+				depth--
+			}
+		}
+		block := css[i+1 : end-1]
+		p := strings.TrimSpace(prelude)
+		switch {
+		case strings.HasPrefix(p, "@media"), strings.HasPrefix(p, "@supports"):
+			fmt.Fprintf(&b, "%s{%s}", prelude, scopeCSS(block, scope))
+		case strings.HasPrefix(p, "@"):
+			fmt.Fprintf(&b, "%s{%s}", prelude, block)
+		default:
+			selectors := []string{}
+			for _, s := range strings.Split(p, ",") {
+				selectors = append(selectors, scopeSelector(s, scope))
+			}
+			lead := prelude[:len(prelude)-len(strings.TrimLeft(prelude, " \t\r\n"))]
+			fmt.Fprintf(&b, "%s%s {%s}", lead, strings.Join(selectors, ", "), block)
+		}
+		css = css[end:]
+	}
+	return b.String()
+}
+
+// scopeSelector adds [scope] to the last compound selector before its pseudo
+// classes: ".a .b:hover" -> ".a .b[scope]:hover".
+func scopeSelector(sel, scope string) string {
+	sel = strings.TrimSpace(sel)
+	depth, start, pos := 0, 0, -1
+	for i := 0; i < len(sel); i++ {
+		switch sel[i] {
+		case '[', '(':
+			depth++
+		case ']', ')':
+			depth--
+		case ' ', '\t', '\r', '\n', '>', '+', '~':
+			if depth == 0 {
+				start, pos = i+1, -1
+			}
+		case ':':
+			if depth == 0 && pos < 0 && i >= start {
+				pos = i
+			}
+		}
+	}
+	if pos < 0 {
+		pos = len(sel)
+	}
+	return sel[:pos] + "[" + scope + "]" + sel[pos:]
+}
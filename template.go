@@ -1,8 +1,48 @@
 package main
 
-import "text/template"
+import (
+	"go/build/constraint"
+	"io"
+	"strings"
+	"text/template"
+)
+
+// buildLines returns the //go:build and // +build lines of the constraint
+// expression, negated for the files of the other builds.
+func buildLines(expr string, not bool) ([]string, error) {
+	x, err := constraint.Parse("//go:build " + expr)
+	if err != nil {
+		return nil, err
+	}
+	if not {
+		x = &constraint.NotExpr{X: x}
+	}
+	lines, err := constraint.PlusBuildLines(x)
+	if err != nil {
+		return nil, err
+	}
+	return append([]string{"//go:build " + x.String()}, lines...), nil
+}
+
+var funcs = template.FuncMap{
+	"goString":   goString,
+	"buildLines": buildLines,
+	"join":       strings.Join,
+	"indent":     indent,
+}
 
-var templ = template.Must(template.New("").Parse(`package {{.PkgName}}
+// indent indents the continuation lines of a statement of a func body.
+func indent(s string) string {
+	return strings.Replace(s, "\n", "\n\t", -1)
+}
+
+// header is the package clause, imports and style of every backend and the
+// script is the code of the go scripts.
+var header = `{{define "header"}}{{if .BuildTag -}}
+{{range buildLines .BuildTag false}}{{.}}
+{{end}}
+{{end -}}
+package {{.PkgName}}
 
 import (
 {{range $v, $_ := .StdImports}}{{printf "\t%q\n" $v}}{{end -}}
@@ -10,6 +50,40 @@ import (
 {{range $v, $_ := .Imports}}{{printf "\t%q\n" $v}}{{end -}}
 )
 
+{{if .Style -}}
+func init() {
+	style := js.Global().Get("document").Call("createElement", "style")
+	style.Set("textContent", {{goString .Style}})
+	js.Global().Get("document").Get("head").Call("appendChild", style)
+}
+
+{{end -}}
+{{end}}
+{{- define "script"}}{{range .AppendCode}}{{.}}
+
+{{end}}{{end}}`
+
+var templ = template.Must(template.New("").Funcs(funcs).Parse(header + `{{template "header" .}}
+{{- if eq .Handlers "methods" -}}
+// New{{.ComponentName}} ...
+func New{{.ComponentName}}() *{{.ComponentName}} {
+	return &{{.ComponentName}}{}
+}
+
+// {{.ComponentName}} ...
+type {{.ComponentName}} struct{
+	vecty.Core
+{{- range .Refs}}
+	{{.}} *vecty.HTML
+{{- end}}
+{{- range .State}}
+	{{.}}
+{{- end}}
+{{- range .Portals}}
+	{{.Field}} *{{$.ComponentName}}Portal
+{{- end}}
+}
+{{- else -}}
 // New{{.ComponentName}} ...
 func New{{.ComponentName}}(d map[string]func(*vecty.Event)) *{{.ComponentName}} {
 	return &{{.ComponentName}}{
@@ -20,22 +94,248 @@ func New{{.ComponentName}}(d map[string]func(*vecty.Event)) *{{.ComponentName}}
 // {{.ComponentName}} ...
 type {{.ComponentName}} struct{
 	vecty.Core
+{{- range .Refs}}
+	{{.}} *vecty.HTML
+{{- end}}
+{{- range .State}}
+	{{.}}
+{{- end}}
+{{- range .Portals}}
+	{{.Field}} *{{$.ComponentName}}Portal
+{{- end}}
 	dispatcher map[string]func(*vecty.Event)
 }
+{{- end}}
 
+{{if .List -}}
+// Render ...
+func (c *{{.ComponentName}}) Render() vecty.ComponentOrHTML {
+	return vecty.Tag("div", c.RenderList())
+}
+
+// RenderList ...
+func (c *{{.ComponentName}}) RenderList() vecty.List {
+	return {{.Generated}}
+}
+{{- else -}}
 // Render ...
 func (c *{{.ComponentName}}) Render() vecty.ComponentOrHTML {
 	return {{.Generated}}
 }
+{{- end}}
+
+{{if .Refs -}}
+// setRef keeps the element of a ref field, its Node is set once mounted.
+func (c *{{.ComponentName}}) setRef(ref **vecty.HTML, h *vecty.HTML) *vecty.HTML {
+	*ref = h
+	return h
+}
+
+{{end -}}
+{{if .Portals -}}
+// {{.ComponentName}}Portal renders the content of a <portal> in place of its
+// target, vecty.Rerender it when the state it shows changes.
+type {{.ComponentName}}Portal struct {
+	vecty.Core
+	render func() vecty.ComponentOrHTML
+}
+
+// Render ...
+func (p *{{.ComponentName}}Portal) Render() vecty.ComponentOrHTML {
+	if p.render == nil {
+		return vecty.Tag("div")
+	}
+	return p.render()
+}
+
+// mountPortal renders a portal into the target of the selector on the first
+// Mount, the next ones render its content again.
+func (c *{{.ComponentName}}) mountPortal(p **{{.ComponentName}}Portal, selector string, render func() vecty.ComponentOrHTML) {
+	if *p != nil {
+		(*p).render = render
+		vecty.Rerender(*p)
+		return
+	}
+	*p = &{{.ComponentName}}Portal{render: render}
+	if err := vecty.RenderInto(selector, *p); err != nil {
+		panic(err)
+	}
+}
+
+{{range .Portals -}}
+// {{.Method}} renders the content of <portal target={{printf "%q" .Target}}>.
+func (c *{{$.ComponentName}}) {{.Method}}() vecty.ComponentOrHTML {
+	return {{.Render}}
+}
 
-{{range $event, $method := .Methods -}}
-// {{$method}} ...
-func (c *{{$.ComponentName}}) {{$method}}(event *vecty.Event) {
-	f, ok := c.dispatcher["{{$method}}"]
+{{end -}}
+{{end -}}
+{{range .Hooks -}}
+// {{.Name}} ...
+func (c *{{$.ComponentName}}) {{.Signature}} {
+	{{.Call}}
+}
+
+{{end -}}
+{{if .Head -}}
+// AddHead ...
+func (c *{{.ComponentName}}) AddHead() {
+{{range .Head}}	{{.}}
+{{end -}}
+}
+
+{{end -}}
+{{range .EventTypes}}{{.}}
+{{end -}}
+{{template "script" .}}
+{{- if ne .Handlers "methods" -}}
+{{range .Methods -}}
+// {{.Name}} handles {{join .Uses ", "}}.
+func (c *{{$.ComponentName}}) {{.Name}}(event *vecty.Event) {
+	f, ok := c.dispatcher["{{.Name}}"]
 	if !ok {
-		panic("unknown func: \"{{$method}}\"")
+		panic("unknown func: \"{{.Name}}\"")
 	}
 	f(event)
 }
+
+{{end -}}
+{{- end}}`))
+
+var goAppTempl = template.Must(template.New("").Funcs(funcs).Parse(header + `{{template "header" .}}
+{{- if eq .Handlers "methods" -}}
+// New{{.ComponentName}} ...
+func New{{.ComponentName}}() *{{.ComponentName}} {
+	return &{{.ComponentName}}{}
+}
+
+// {{.ComponentName}} ...
+type {{.ComponentName}} struct{
+	app.Compo
+{{- range .Refs}}
+	{{.}} app.UI
+{{- end}}
+{{- range .State}}
+	{{.}}
+{{- end}}
+}
+{{- else -}}
+// New{{.ComponentName}} ...
+func New{{.ComponentName}}(d map[string]app.EventHandler) *{{.ComponentName}} {
+	return &{{.ComponentName}}{
+		dispatcher: d,
+	}
+}
+
+// {{.ComponentName}} ...
+type {{.ComponentName}} struct{
+	app.Compo
+{{- range .Refs}}
+	{{.}} app.UI
+{{- end}}
+{{- range .State}}
+	{{.}}
+{{- end}}
+	dispatcher map[string]app.EventHandler
+}
+{{- end}}
+
+// Render ...
+func (c *{{.ComponentName}}) Render() app.UI {
+	return {{.Generated}}
+}
+
+{{if .Refs -}}
+// setRef keeps the element of a ref field, its JSValue is set once mounted.
+func (c *{{.ComponentName}}) setRef(ref *app.UI, e app.UI) app.UI {
+	*ref = e
+	return e
+}
+
+{{end -}}
+{{template "script" .}}
+{{- if ne .Handlers "methods" -}}
+{{range .Methods -}}
+// {{.Name}} handles {{join .Uses ", "}}.
+func (c *{{$.ComponentName}}) {{.Name}}(ctx app.Context, e app.Event) {
+	f, ok := c.dispatcher["{{.Name}}"]
+	if !ok {
+		panic("unknown func: \"{{.Name}}\"")
+	}
+	f(ctx, e)
+}
+
+{{end -}}
+{{- end}}`))
+
+var htmlTempl = template.Must(template.New("").Funcs(funcs).Parse(`{{range buildLines .BuildTag true}}{{.}}
 {{end}}
+package {{.PkgName}}
+
+import (
+	"io"
+{{- if .Imports}}
+{{range $v, $_ := .Imports}}{{printf "\n\t%q" $v}}{{end}}
+{{- end}}
+)
+
+// {{.ComponentName}} ...
+type {{.ComponentName}} struct{
+{{- if .Props}}
+{{- range .Props}}
+	{{.}}
+{{- end}}
+{{end -}}
+}
+
+// RenderHTML ...
+func (c *{{.ComponentName}}) RenderHTML(w io.Writer) error {
+{{range .HTML}}	{{indent .}}
+{{end}}	return nil
+}
 `))
+
+// Write writes the component source with the Render body generated by Do.
+func (c *Converter) Write(w io.Writer, generated, pkg, name string) error {
+	return c.Backend.Write(c, w, generated, pkg, name)
+}
+
+// data returns the template values of the component source.
+func (c *Converter) data(generated, pkg, name string) map[string]interface{} {
+	return map[string]interface{}{
+		"PkgName":       pkg,
+		"StdImports":    c.StdModules,
+		"Imports":       c.ExtModules,
+		"ComponentName": name,
+		"Generated":     generated,
+		"Methods":       c.Methods,
+		"Hooks":         c.Hooks,
+		"Refs":          c.Refs,
+		"State":         c.State,
+		"Portals":       c.Portals,
+		"EventTypes":    c.eventTypes(),
+		"AppendCode":    c.AppendCode,
+		"Handlers":      c.Handlers,
+		"Head":          c.Head,
+		"Style":         c.Style,
+		"BuildTag":      c.BuildTag,
+		"List":          c.List,
+	}
+}
+
+// WriteHTML writes the RenderHTML source generated by Do, it is built where
+// the component source is not.
+func (c *Converter) WriteHTML(w io.Writer, pkg, name string) error {
+	buildTag := c.BuildTag
+	if len(buildTag) == 0 {
+		buildTag = "js"
+	}
+	return htmlTempl.Execute(w, map[string]interface{}{
+		"BuildTag":      buildTag,
+		"PkgName":       pkg,
+		"ComponentName": name,
+		"Props":         c.Props,
+		"HTML":          c.HTML,
+		"Imports":       c.HTMLModules,
+	})
+}
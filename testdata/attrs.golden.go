@@ -0,0 +1,39 @@
+package main
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/prop"
+)
+
+// NewAttrs ...
+func NewAttrs(d map[string]func(*vecty.Event)) *Attrs {
+	return &Attrs{
+		dispatcher: d,
+	}
+}
+
+// Attrs ...
+type Attrs struct{
+	vecty.Core
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Attrs) Render() vecty.ComponentOrHTML {
+	return elem.Div(
+		vecty.Markup(
+			vecty.Property("aria-hidden", "false"),
+			vecty.Property("draggable", "true"),
+			vecty.Property("hidden", true),
+		),
+		elem.Input(
+			vecty.Markup(
+				prop.Type("checkbox"),
+				prop.Checked(true),
+				prop.Value("true"),
+			),
+		),
+	)
+}
+
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+)
+
+// NewClass ...
+func NewClass(d map[string]func(*vecty.Event)) *Class {
+	return &Class{
+		dispatcher: d,
+	}
+}
+
+// Class ...
+type Class struct{
+	vecty.Core
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Class) Render() vecty.ComponentOrHTML {
+	return elem.UnorderedList(
+		vecty.Markup(
+			vecty.Class("menu", "main"),
+		),
+		elem.ListItem(
+			vecty.Markup(
+				vecty.ClassMap{
+					"item": true,
+					"active": c.Selected(),
+					"disabled": !c.Enabled(),
+					"is-open": c.Open(),
+				},
+			),
+			vecty.Text("first"),
+		),
+		elem.ListItem(
+			vecty.Markup(
+				vecty.ClassMap{
+					"item": true,
+					c.Kind(): true,
+					"wide": len(c.Items()) > 3,
+				},
+			),
+			vecty.Text("second"),
+		),
+	)
+}
+
@@ -0,0 +1,7 @@
+package main
+
+func (c *Class) Selected() bool  { return true }
+func (c *Class) Enabled() bool   { return true }
+func (c *Class) Open() bool      { return false }
+func (c *Class) Kind() string    { return "primary" }
+func (c *Class) Items() []string { return nil }
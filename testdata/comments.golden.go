@@ -0,0 +1,38 @@
+package main
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+)
+
+// NewComments ...
+func NewComments(d map[string]func(*vecty.Event)) *Comments {
+	return &Comments{
+		dispatcher: d,
+	}
+}
+
+// Comments ...
+type Comments struct{
+	vecty.Core
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Comments) Render() vecty.ComponentOrHTML {
+	return /* page */ elem.Div(
+		vecty.Markup(
+			vecty.Class("page"),
+		),
+		// sidebar
+		// second line
+		elem.Navigation(
+			vecty.Markup(
+				vecty.Class("side"),
+			),
+			vecty.Text("menu"),
+		),
+		// end of */ sidebar
+	)
+}
+
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"example.com/ui/widgets"
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+)
+
+// NewComponents ...
+func NewComponents() *Components {
+	return &Components{}
+}
+
+// Components ...
+type Components struct{
+	vecty.Core
+}
+
+// Render ...
+func (c *Components) Render() vecty.ComponentOrHTML {
+	return elem.UnorderedList(
+		vecty.Markup(
+			vecty.Class("todos"),
+		),
+		&TodoItem{Title: "Write the docs", Done: "true"},
+		&widgets.Panel{Heading: "Archive"},
+		elem.Button(
+			vecty.Markup(
+				event.Click(c.Clear),
+			),
+			vecty.Text("Clear"),
+		),
+	)
+}
+
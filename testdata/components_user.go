@@ -0,0 +1,13 @@
+package main
+
+import "github.com/gopherjs/vecty"
+
+type TodoItem struct {
+	vecty.Core
+	Title string
+	Done  string
+}
+
+func (t *TodoItem) Render() vecty.ComponentOrHTML { return nil }
+
+func (c *Components) Clear(event *vecty.Event) {}
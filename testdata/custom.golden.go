@@ -0,0 +1,54 @@
+package main
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+)
+
+// NewCustom ...
+func NewCustom(d map[string]func(*vecty.Event)) *Custom {
+	return &Custom{
+		dispatcher: d,
+	}
+}
+
+// Custom ...
+type Custom struct{
+	vecty.Core
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Custom) Render() vecty.ComponentOrHTML {
+	return vecty.Tag("sl-dialog", 
+		vecty.Markup(
+			vecty.Attribute("label", "Settings"),
+			vecty.Attribute("open", ""),
+			vecty.Class("modal"),
+			&vecty.EventListener{Name: "sl-hide", Listener: c.Closed},
+		),
+		vecty.Tag("sl-input", 
+			vecty.Markup(
+				vecty.Attribute("value", "x"),
+				vecty.Attribute("disabled", ""),
+				&vecty.EventListener{Name: "sl-change", Listener: func(e *vecty.Event) { c.SetValue(e.Target.Get("value").String()) }},
+			),
+		),
+		elem.Div(
+			vecty.Markup(
+				&vecty.EventListener{Name: "transitionrun", Listener: c.Closed},
+			),
+			vecty.Text("anim"),
+		),
+	)
+}
+
+// Closed handles @transitionrun on <div>, @sl-hide on <sl-dialog>.
+func (c *Custom) Closed(event *vecty.Event) {
+	f, ok := c.dispatcher["Closed"]
+	if !ok {
+		panic("unknown func: \"Closed\"")
+	}
+	f(event)
+}
+
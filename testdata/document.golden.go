@@ -0,0 +1,94 @@
+package main
+
+import (
+	"syscall/js"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+	"github.com/gopherjs/vecty/prop"
+)
+
+// NewDocument ...
+func NewDocument(d map[string]func(*vecty.Event)) *Document {
+	return &Document{
+		dispatcher: d,
+	}
+}
+
+// Document ...
+type Document struct{
+	vecty.Core
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Document) Render() vecty.ComponentOrHTML {
+	return elem.Body(
+		vecty.Markup(
+			vecty.Class("app"),
+		),
+		elem.Heading1(
+			vecty.Text("Hello"),
+		),
+		elem.Button(
+			vecty.Markup(
+				event.Click(c.Click),
+			),
+			vecty.Text("Click"),
+		),
+		elem.Div(
+			vecty.Markup(
+				prop.ID("modal"),
+			),
+		),
+	)
+}
+
+// AddHead ...
+func (c *Document) AddHead() {
+	{
+		e := js.Global().Get("document").Call("createElement", "meta")
+		e.Call("setAttribute", "charset", "utf-8")
+		js.Global().Get("document").Get("head").Call("appendChild", e)
+	}
+	{
+		e := js.Global().Get("document").Call("createElement", "meta")
+		e.Call("setAttribute", "name", "viewport")
+		e.Call("setAttribute", "content", "width=device-width, initial-scale=1")
+		js.Global().Get("document").Get("head").Call("appendChild", e)
+	}
+	vecty.SetTitle("My Page")
+	vecty.AddStylesheet("/css/app.css")
+	{
+		e := js.Global().Get("document").Call("createElement", "link")
+		e.Call("setAttribute", "rel", "icon")
+		e.Call("setAttribute", "href", "/favicon.ico")
+		js.Global().Get("document").Get("head").Call("appendChild", e)
+	}
+	{
+		e := js.Global().Get("document").Call("createElement", "script")
+		e.Call("setAttribute", "src", "/js/vendor.js")
+		js.Global().Get("document").Get("head").Call("appendChild", e)
+	}
+	{
+		e := js.Global().Get("document").Call("createElement", "style")
+		e.Set("textContent", "body { margin: 0; }")
+		js.Global().Get("document").Get("head").Call("appendChild", e)
+	}
+	{
+		e := js.Global().Get("document").Call("createElement", "script")
+		e.Call("setAttribute", "src", "/js/late.js")
+		js.Global().Get("document").Get("head").Call("appendChild", e)
+	}
+}
+
+// Click handles @click on <button>.
+func (c *Document) Click(event *vecty.Event) {
+	f, ok := c.dispatcher["Click"]
+	if !ok {
+		panic("unknown func: \"Click\"")
+	}
+	f(event)
+}
+
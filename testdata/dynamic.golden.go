@@ -0,0 +1,47 @@
+package main
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+)
+
+// NewDynamic ...
+func NewDynamic(d map[string]func(*vecty.Event)) *Dynamic {
+	return &Dynamic{
+		dispatcher: d,
+	}
+}
+
+// Dynamic ...
+type Dynamic struct{
+	vecty.Core
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Dynamic) Render() vecty.ComponentOrHTML {
+	return elem.Section(
+		vecty.Markup(
+			vecty.Class("tabs"),
+		),
+		vecty.Tag(c.Heading(), 
+			vecty.Markup(
+				vecty.Class("title"),
+			),
+			vecty.Text("Tabs"),
+		),
+		func() vecty.MarkupOrChild {
+			if c.Current() != nil {
+				return c.Current()
+			}
+			return nil
+		}(),
+		elem.Paragraph(
+			vecty.Markup(
+				vecty.Property("title", c.Heading()),
+			),
+			vecty.Text("footer"),
+		),
+	)
+}
+
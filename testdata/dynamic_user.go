@@ -0,0 +1,6 @@
+package main
+
+import "github.com/gopherjs/vecty"
+
+func (c *Dynamic) Heading() string                { return "h2" }
+func (c *Dynamic) Current() vecty.ComponentOrHTML { return nil }
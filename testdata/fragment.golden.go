@@ -0,0 +1,49 @@
+package main
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+)
+
+// NewFragment ...
+func NewFragment(d map[string]func(*vecty.Event)) *Fragment {
+	return &Fragment{
+		dispatcher: d,
+	}
+}
+
+// Fragment ...
+type Fragment struct{
+	vecty.Core
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Fragment) Render() vecty.ComponentOrHTML {
+	return elem.Div(
+		vecty.Text("Total:"),
+		elem.Span(
+			vecty.Markup(
+				vecty.Class("count"),
+			),
+			vecty.Text("3"),
+		),
+		elem.Button(
+			vecty.Markup(
+				event.Click(c.Clear),
+			),
+			vecty.Text("Clear"),
+		),
+	)
+}
+
+// Clear handles @click on <button>.
+func (c *Fragment) Clear(event *vecty.Event) {
+	f, ok := c.dispatcher["Clear"]
+	if !ok {
+		panic("unknown func: \"Clear\"")
+	}
+	f(event)
+}
+
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"syscall/js"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+)
+
+// NewGlobals ...
+func NewGlobals(d map[string]func(*vecty.Event)) *Globals {
+	return &Globals{
+		dispatcher: d,
+	}
+}
+
+// Globals ...
+type Globals struct{
+	vecty.Core
+	windowListener0 js.Func
+	documentListener1 js.Func
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Globals) Render() vecty.ComponentOrHTML {
+	return elem.Div(
+		vecty.Markup(
+			vecty.Class("dialog"),
+		),
+		elem.Button(
+			vecty.Markup(
+				event.Click(func(e *vecty.Event) { c.Close() }),
+			),
+			vecty.Text("close"),
+		),
+	)
+}
+
+// Mount ...
+func (c *Globals) Mount() {
+	c.windowListener0 = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		c.OnResize(&vecty.Event{Value: args[0], Target: args[0].Get("target")})
+		return nil
+	})
+	js.Global().Call("addEventListener", "resize", c.windowListener0)
+	c.documentListener1 = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		func(e *vecty.Event) { if e.Value.Get("key").String() == "Escape" { e.Value.Call("preventDefault"); c.Close() } }(&vecty.Event{Value: args[0], Target: args[0].Get("target")})
+		return nil
+	})
+	js.Global().Get("document").Call("addEventListener", "keydown", c.documentListener1)
+}
+
+// Unmount ...
+func (c *Globals) Unmount() {
+	c.OnUnmount()
+	js.Global().Call("removeEventListener", "resize", c.windowListener0)
+	c.windowListener0.Release()
+	js.Global().Get("document").Call("removeEventListener", "keydown", c.documentListener1)
+	c.documentListener1.Release()
+}
+
+// OnResize handles @window:resize on <div>.
+func (c *Globals) OnResize(event *vecty.Event) {
+	f, ok := c.dispatcher["OnResize"]
+	if !ok {
+		panic("unknown func: \"OnResize\"")
+	}
+	f(event)
+}
+
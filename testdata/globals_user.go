@@ -0,0 +1,4 @@
+package main
+
+func (c *Globals) OnUnmount() {}
+func (c *Globals) Close()     {}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"github.com/maxence-charriere/go-app/v9/pkg/app"
+)
+
+// NewGoapp ...
+func NewGoapp(d map[string]app.EventHandler) *Goapp {
+	return &Goapp{
+		dispatcher: d,
+	}
+}
+
+// Goapp ...
+type Goapp struct{
+	app.Compo
+	dispatcher map[string]app.EventHandler
+}
+
+// Render ...
+func (c *Goapp) Render() app.UI {
+	return app.Div().ID("app").Class("container", "main").Body(
+		app.H1().Body(
+			app.Text("Hello"),
+		),
+		app.Label().Attr("for", "done").Body(
+			app.Text("Done"),
+		),
+		app.Input().ID("done").Attr("type", "checkbox").Attr("checked", true).On("change", c.Toggled),
+		app.Button().Class("btn").On("click", c.Clicked).Body(
+			app.Text("Click"),
+		),
+		app.FieldSet().Body(
+			app.Legend().Body(
+				app.Text("Options"),
+			),
+			app.Textarea().ID("notes"),
+		),
+		app.IFrame().Attr("src", "/frame"),
+		app.Elem("x-chart").Attr("data-src", "/stats"),
+		app.Div().Body(
+			app.Raw("<b>bold</b>"),
+		),
+	)
+}
+
+// Clicked handles @click on <button>.
+func (c *Goapp) Clicked(ctx app.Context, e app.Event) {
+	f, ok := c.dispatcher["Clicked"]
+	if !ok {
+		panic("unknown func: \"Clicked\"")
+	}
+	f(ctx, e)
+}
+
+// Toggled handles @change on <input>.
+func (c *Goapp) Toggled(ctx app.Context, e app.Event) {
+	f, ok := c.dispatcher["Toggled"]
+	if !ok {
+		panic("unknown func: \"Toggled\"")
+	}
+	f(ctx, e)
+}
+
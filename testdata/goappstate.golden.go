@@ -0,0 +1,47 @@
+package main
+
+import (
+	"github.com/maxence-charriere/go-app/v9/pkg/app"
+)
+
+// NewGoappstate ...
+func NewGoappstate(d map[string]app.EventHandler) *Goappstate {
+	return &Goappstate{
+		dispatcher: d,
+	}
+}
+
+// Goappstate ...
+type Goappstate struct{
+	app.Compo
+	field app.UI
+	Title string
+	once1 bool
+	dispatcher map[string]app.EventHandler
+}
+
+// Render ...
+func (c *Goappstate) Render() app.UI {
+	return app.Div().Attr("title", c.Title).Body(
+		c.setRef(&c.field, app.Input().On("keyup", func(ctx app.Context, e app.Event) { if e.Value.Get("key").String() == "Enter" { c.Submit() } })),
+		app.Button().On("click", func(ctx app.Context, e app.Event) { if c.once1 { return }; c.once1 = true; c.Clicked(ctx, e) }).Body(
+			app.Text("Once"),
+		),
+	)
+}
+
+// setRef keeps the element of a ref field, its JSValue is set once mounted.
+func (c *Goappstate) setRef(ref *app.UI, e app.UI) app.UI {
+	*ref = e
+	return e
+}
+
+// Clicked handles @click on <button>.
+func (c *Goappstate) Clicked(ctx app.Context, e app.Event) {
+	f, ok := c.dispatcher["Clicked"]
+	if !ok {
+		panic("unknown func: \"Clicked\"")
+	}
+	f(ctx, e)
+}
+
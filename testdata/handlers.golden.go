@@ -0,0 +1,143 @@
+package main
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+	"github.com/gopherjs/vecty/prop"
+)
+
+// NewHandlers ...
+func NewHandlers(d map[string]func(*vecty.Event)) *Handlers {
+	return &Handlers{
+		dispatcher: d,
+	}
+}
+
+// Handlers ...
+type Handlers struct{
+	vecty.Core
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Handlers) Render() vecty.ComponentOrHTML {
+	return elem.UnorderedList(
+		vecty.Markup(
+			vecty.Class("items"),
+		),
+		elem.ListItem(
+			elem.Input(
+				vecty.Markup(
+					event.Input(func(ev *vecty.Event) { e := HandlersTextEvent{ev}; c.SetName(e.Value()) }),
+				),
+			),
+			elem.Input(
+				vecty.Markup(
+					prop.Type("number"),
+					event.Change(func(ev *vecty.Event) { e := HandlersNumberEvent{ev}; c.Remove(e.ValueAsInt()) }),
+				),
+			),
+			elem.Input(
+				vecty.Markup(
+					prop.Type("checkbox"),
+					event.Change(c.Toggled),
+				),
+			),
+			elem.Input(
+				vecty.Markup(
+					prop.Type("checkbox"),
+					event.Input(func(ev *vecty.Event) { e := HandlersCheckEvent{ev}; c.SetDone(e.Checked()) }),
+				),
+			),
+			elem.Button(
+				vecty.Markup(
+					event.Click(func(e *vecty.Event) { c.Remove(1) }),
+				),
+				vecty.Text("Remove"),
+			),
+			elem.Button(
+				vecty.Markup(
+					event.DoubleClick(func(e *vecty.Event) { removed++; c.Remove(removed) }),
+				),
+				vecty.Text("Twice"),
+			),
+			elem.Button(
+				vecty.Markup(
+					event.Click(c.Reset),
+				),
+				vecty.Text("Reset"),
+			),
+			elem.Button(
+				vecty.Markup(
+					event.Click(c.Save),
+				),
+				vecty.Text("Save"),
+			),
+		),
+	)
+}
+
+// HandlersCheckEvent is the event of check form elements.
+type HandlersCheckEvent struct {
+	*vecty.Event
+}
+
+// Value ...
+func (e HandlersCheckEvent) Value() string {
+	return e.Target.Get("value").String()
+}
+
+// Checked ...
+func (e HandlersCheckEvent) Checked() bool {
+	return e.Target.Get("checked").Bool()
+}
+
+// HandlersNumberEvent is the event of number form elements.
+type HandlersNumberEvent struct {
+	*vecty.Event
+}
+
+// Value ...
+func (e HandlersNumberEvent) Value() string {
+	return e.Target.Get("value").String()
+}
+
+// ValueAsFloat ...
+func (e HandlersNumberEvent) ValueAsFloat() float64 {
+	return e.Target.Get("valueAsNumber").Float()
+}
+
+// ValueAsInt ...
+func (e HandlersNumberEvent) ValueAsInt() int {
+	return e.Target.Get("valueAsNumber").Int()
+}
+
+// HandlersTextEvent is the event of text form elements.
+type HandlersTextEvent struct {
+	*vecty.Event
+}
+
+// Value ...
+func (e HandlersTextEvent) Value() string {
+	return e.Target.Get("value").String()
+}
+
+// Reset handles @click on <button>.
+func (c *Handlers) Reset(event *vecty.Event) {
+	f, ok := c.dispatcher["Reset"]
+	if !ok {
+		panic("unknown func: \"Reset\"")
+	}
+	f(event)
+}
+
+// Toggled handles @change on <input>.
+func (c *Handlers) Toggled(event *vecty.Event) {
+	f, ok := c.dispatcher["Toggled"]
+	if !ok {
+		panic("unknown func: \"Toggled\"")
+	}
+	f(event)
+}
+
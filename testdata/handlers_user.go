@@ -0,0 +1,14 @@
+package main
+
+import "github.com/gopherjs/vecty"
+
+var removed int
+
+func (c *Handlers) SetName(name string) {}
+func (c *Handlers) Remove(id int)       {}
+func (c *Handlers) SetDone(done bool)   {}
+func (c *Handlers) Save(e *vecty.Event) {}
+
+func toggled(e *vecty.Event) bool {
+	return HandlersCheckEvent{e}.Checked()
+}
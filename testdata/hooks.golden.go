@@ -0,0 +1,54 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+)
+
+// NewHooks ...
+func NewHooks(d map[string]func(*vecty.Event)) *Hooks {
+	return &Hooks{
+		dispatcher: d,
+	}
+}
+
+// Hooks ...
+type Hooks struct{
+	vecty.Core
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Hooks) Render() vecty.ComponentOrHTML {
+	return elem.Div(
+		vecty.Markup(
+			vecty.Class("clock"),
+		),
+		elem.Span(
+			vecty.Text("now"),
+		),
+	)
+}
+
+// Mount ...
+func (c *Hooks) Mount() {
+	c.OnMount()
+}
+
+// Unmount ...
+func (c *Hooks) Unmount() {
+	c.OnUnmount()
+}
+
+// SkipRender ...
+func (c *Hooks) SkipRender(prev vecty.Component) bool {
+	return ShouldSkip(c, prev)
+}
+
+// ShouldSkip renders the clock once.
+func ShouldSkip(c *Hooks, prev vecty.Component) bool {
+	return strings.HasPrefix(c.label(), "fixed")
+}
+
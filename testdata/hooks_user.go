@@ -0,0 +1,5 @@
+package main
+
+func (c *Hooks) label() string { return "fixed" }
+func (c *Hooks) OnMount()      {}
+func (c *Hooks) OnUnmount()    {}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+)
+
+// NewList ...
+func NewList(d map[string]func(*vecty.Event)) *List {
+	return &List{
+		dispatcher: d,
+	}
+}
+
+// List ...
+type List struct{
+	vecty.Core
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *List) Render() vecty.ComponentOrHTML {
+	return vecty.Tag("div", c.RenderList())
+}
+
+// RenderList ...
+func (c *List) RenderList() vecty.List {
+	return vecty.List{
+		vecty.Text("Total:"),
+		elem.Span(
+			vecty.Markup(
+				vecty.Class("count"),
+			),
+			vecty.Text("3"),
+		),
+		elem.Button(
+			vecty.Markup(
+				event.Click(c.Clear),
+			),
+			vecty.Text("Clear"),
+		),
+	}
+}
+
+// Clear handles @click on <button>.
+func (c *List) Clear(event *vecty.Event) {
+	f, ok := c.dispatcher["Clear"]
+	if !ok {
+		panic("unknown func: \"Clear\"")
+	}
+	f(event)
+}
+
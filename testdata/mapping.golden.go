@@ -0,0 +1,52 @@
+package main
+
+import (
+	"example.com/ui/widgets"
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+)
+
+// NewMapping ...
+func NewMapping(d map[string]func(*vecty.Event)) *Mapping {
+	return &Mapping{
+		dispatcher: d,
+	}
+}
+
+// Mapping ...
+type Mapping struct{
+	vecty.Core
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Mapping) Render() vecty.ComponentOrHTML {
+	return widgets.Card(
+		vecty.Markup(
+			widgets.Title("Settings"),
+			vecty.Property("hidden", true),
+		),
+		vecty.Tag("title", 
+			vecty.Text("custom title element"),
+		),
+		elem.Details(
+			vecty.Markup(
+				widgets.Toggle(c.Toggled),
+			),
+			vecty.Text("more"),
+		),
+		elem.Aside(
+			vecty.Text("side"),
+		),
+	)
+}
+
+// Toggled handles @toggle on <details>.
+func (c *Mapping) Toggled(event *vecty.Event) {
+	f, ok := c.dispatcher["Toggled"]
+	if !ok {
+		panic("unknown func: \"Toggled\"")
+	}
+	f(event)
+}
+
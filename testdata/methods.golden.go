@@ -0,0 +1,93 @@
+package main
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+	"github.com/gopherjs/vecty/prop"
+)
+
+// NewMethods ...
+func NewMethods(d map[string]func(*vecty.Event)) *Methods {
+	return &Methods{
+		dispatcher: d,
+	}
+}
+
+// Methods ...
+type Methods struct{
+	vecty.Core
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Methods) Render() vecty.ComponentOrHTML {
+	return elem.Form(
+		vecty.Markup(
+			vecty.Class("dialog"),
+		),
+		elem.Input(
+			vecty.Markup(
+				event.KeyDown(c.Edited),
+				event.Change(c.Edited),
+			),
+		),
+		elem.Button(
+			vecty.Markup(
+				event.Click(c.Save),
+			),
+			vecty.Text("Save"),
+		),
+		elem.Button(
+			vecty.Markup(
+				event.Click(c.Cancel),
+			),
+			vecty.Text("Cancel"),
+		),
+		elem.Anchor(
+			vecty.Markup(
+				prop.Href("#"),
+				event.Click(c.Cancel),
+			),
+			vecty.Text("close"),
+		),
+	)
+}
+
+// MethodsTextEvent is the event of text form elements.
+type MethodsTextEvent struct {
+	*vecty.Event
+}
+
+// Value ...
+func (e MethodsTextEvent) Value() string {
+	return e.Target.Get("value").String()
+}
+
+// Cancel handles @click on <button>, @click on <a>.
+func (c *Methods) Cancel(event *vecty.Event) {
+	f, ok := c.dispatcher["Cancel"]
+	if !ok {
+		panic("unknown func: \"Cancel\"")
+	}
+	f(event)
+}
+
+// Edited handles @keydown on <input>, @change on <input>.
+func (c *Methods) Edited(event *vecty.Event) {
+	f, ok := c.dispatcher["Edited"]
+	if !ok {
+		panic("unknown func: \"Edited\"")
+	}
+	f(event)
+}
+
+// Save handles @click on <button>.
+func (c *Methods) Save(event *vecty.Event) {
+	f, ok := c.dispatcher["Save"]
+	if !ok {
+		panic("unknown func: \"Save\"")
+	}
+	f(event)
+}
+
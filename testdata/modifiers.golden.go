@@ -0,0 +1,111 @@
+package main
+
+import (
+	"time"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+	"github.com/gopherjs/vecty/prop"
+)
+
+// NewModifiers ...
+func NewModifiers(d map[string]func(*vecty.Event)) *Modifiers {
+	return &Modifiers{
+		dispatcher: d,
+	}
+}
+
+// Modifiers ...
+type Modifiers struct{
+	vecty.Core
+	debounce0 *time.Timer
+	once1 bool
+	throttle2 time.Time
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Modifiers) Render() vecty.ComponentOrHTML {
+	return elem.Div(
+		vecty.Markup(
+			vecty.Class("search"),
+		),
+		elem.Input(
+			vecty.Markup(
+				prop.Type("search"),
+				event.KeyUp(func(e *vecty.Event) { if e.Value.Get("key").String() == "Enter" { c.Search(e) } }),
+				event.KeyDown(func(e *vecty.Event) { if e.Value.Get("key").String() == "Escape" { e.Value.Call("preventDefault"); c.Clear() } }),
+				event.Input(func(ev *vecty.Event) { if c.debounce0 != nil { c.debounce0.Stop() }; c.debounce0 = time.AfterFunc(300 * time.Millisecond, func() { e := ModifiersTextEvent{ev}; c.Query(e.Value()) }) }),
+			),
+		),
+		elem.TextArea(
+			vecty.Markup(
+				event.KeyDown(func(e *vecty.Event) { if e.Value.Get("ctrlKey").Bool() && e.Value.Get("key").String() == "s" { e.Value.Call("preventDefault"); e.Value.Call("stopPropagation"); c.Save(e) } }),
+			),
+		),
+		elem.Button(
+			vecty.Markup(
+				event.Click(func(e *vecty.Event) { if c.once1 { return }; c.once1 = true; c.Save(e) }),
+			),
+			vecty.Text("Save once"),
+		),
+		elem.Div(
+			vecty.Markup(
+				event.Scroll(func(e *vecty.Event) { if time.Since(c.throttle2) < 1 * time.Second { return }; c.throttle2 = time.Now(); c.Scrolled(e) }),
+			),
+		),
+		vecty.Tag("sl-tab-group", 
+			vecty.Markup(
+				(&vecty.EventListener{Name: "sl-tab-show", Listener: c.Shown}).PreventDefault(),
+			),
+		),
+	)
+}
+
+// ModifiersTextEvent is the event of text form elements.
+type ModifiersTextEvent struct {
+	*vecty.Event
+}
+
+// Value ...
+func (e ModifiersTextEvent) Value() string {
+	return e.Target.Get("value").String()
+}
+
+// Save handles @keydown on <textarea>, @click on <button>.
+func (c *Modifiers) Save(event *vecty.Event) {
+	f, ok := c.dispatcher["Save"]
+	if !ok {
+		panic("unknown func: \"Save\"")
+	}
+	f(event)
+}
+
+// Scrolled handles @scroll on <div>.
+func (c *Modifiers) Scrolled(event *vecty.Event) {
+	f, ok := c.dispatcher["Scrolled"]
+	if !ok {
+		panic("unknown func: \"Scrolled\"")
+	}
+	f(event)
+}
+
+// Search handles @keyup on <input>.
+func (c *Modifiers) Search(event *vecty.Event) {
+	f, ok := c.dispatcher["Search"]
+	if !ok {
+		panic("unknown func: \"Search\"")
+	}
+	f(event)
+}
+
+// Shown handles @sl-tab-show on <sl-tab-group>.
+func (c *Modifiers) Shown(event *vecty.Event) {
+	f, ok := c.dispatcher["Shown"]
+	if !ok {
+		panic("unknown func: \"Shown\"")
+	}
+	f(event)
+}
+
@@ -0,0 +1,4 @@
+package main
+
+func (c *Modifiers) Clear()             {}
+func (c *Modifiers) Query(text string) {}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+	"github.com/gopherjs/vecty/prop"
+)
+
+// NewPortal ...
+func NewPortal(d map[string]func(*vecty.Event)) *Portal {
+	return &Portal{
+		dispatcher: d,
+	}
+}
+
+// Portal ...
+type Portal struct{
+	vecty.Core
+	portal0 *PortalPortal
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Portal) Render() vecty.ComponentOrHTML {
+	return elem.Div(
+		vecty.Markup(
+			vecty.Class("page"),
+		),
+		elem.Button(
+			vecty.Markup(
+				event.Click(c.Open),
+			),
+			vecty.Text("open"),
+		),
+	)
+}
+
+// PortalPortal renders the content of a <portal> in place of its
+// target, vecty.Rerender it when the state it shows changes.
+type PortalPortal struct {
+	vecty.Core
+	render func() vecty.ComponentOrHTML
+}
+
+// Render ...
+func (p *PortalPortal) Render() vecty.ComponentOrHTML {
+	if p.render == nil {
+		return vecty.Tag("div")
+	}
+	return p.render()
+}
+
+// mountPortal renders a portal into the target of the selector on the first
+// Mount, the next ones render its content again.
+func (c *Portal) mountPortal(p **PortalPortal, selector string, render func() vecty.ComponentOrHTML) {
+	if *p != nil {
+		(*p).render = render
+		vecty.Rerender(*p)
+		return
+	}
+	*p = &PortalPortal{render: render}
+	if err := vecty.RenderInto(selector, *p); err != nil {
+		panic(err)
+	}
+}
+
+// renderPortal0 renders the content of <portal target="#modal-root">.
+func (c *Portal) renderPortal0() vecty.ComponentOrHTML {
+	return elem.Div(
+		vecty.Markup(
+			vecty.Class("modal"),
+			prop.ID("modal-root"),
+		),
+		elem.Paragraph(
+			vecty.Text("modal"),
+		),
+		elem.Button(
+			vecty.Markup(
+				event.Click(c.Close),
+			),
+			vecty.Text("close"),
+		),
+	)
+}
+
+// Mount ...
+func (c *Portal) Mount() {
+	c.mountPortal(&c.portal0, "#modal-root", c.renderPortal0)
+	c.OnMount()
+}
+
+// Unmount ...
+func (c *Portal) Unmount() {
+	c.portal0.render = nil
+	vecty.Rerender(c.portal0)
+}
+
+// Close handles @click on <button>.
+func (c *Portal) Close(event *vecty.Event) {
+	f, ok := c.dispatcher["Close"]
+	if !ok {
+		panic("unknown func: \"Close\"")
+	}
+	f(event)
+}
+
+// Open handles @click on <button>.
+func (c *Portal) Open(event *vecty.Event) {
+	f, ok := c.dispatcher["Open"]
+	if !ok {
+		panic("unknown func: \"Open\"")
+	}
+	f(event)
+}
+
@@ -0,0 +1,3 @@
+package main
+
+func (c *Portal) OnMount() {}
@@ -0,0 +1,19 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+)
+
+// Attrs ...
+type Attrs struct{}
+
+// RenderHTML ...
+func (c *Attrs) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<div aria-hidden=\"false\" draggable=\"true\" hidden><input type=\"checkbox\" checked value=\"true\"></div>"); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+
+	"html"
+	"strings"
+)
+
+// Class ...
+type Class struct{}
+
+// RenderHTML ...
+func (c *Class) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<ul class=\"menu main\"><li class=\""); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, func() string { s := []string{}; s = append(s, "item"); if c.Selected() { s = append(s, "active") }; if !c.Enabled() { s = append(s, "disabled") }; if c.Open() { s = append(s, "is-open") }; return html.EscapeString(strings.Join(s, " ")) }()); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, "\">first</li><li class=\""); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, func() string { s := []string{}; s = append(s, "item"); s = append(s, c.Kind()); if len(c.Items()) > 3 { s = append(s, "wide") }; return html.EscapeString(strings.Join(s, " ")) }()); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, "\">second</li></ul>"); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+)
+
+// Comments ...
+type Comments struct{}
+
+// RenderHTML ...
+func (c *Comments) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<div class=\"page\"><nav class=\"side\">menu</nav></div>"); err != nil {
+		return err
+	}
+	return nil
+}
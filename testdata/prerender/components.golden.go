@@ -0,0 +1,30 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+
+	"example.com/ui/widgets"
+)
+
+// Components ...
+type Components struct{}
+
+// RenderHTML ...
+func (c *Components) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<ul class=\"todos\">"); err != nil {
+		return err
+	}
+	if err := (&TodoItem{Title: "Write the docs", Done: "true"}).RenderHTML(w); err != nil {
+		return err
+	}
+	if err := (&widgets.Panel{Heading: "Archive"}).RenderHTML(w); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, "<button>Clear</button></ul>"); err != nil {
+		return err
+	}
+	return nil
+}
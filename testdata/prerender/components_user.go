@@ -0,0 +1,16 @@
+package main
+
+import (
+	"fmt"
+	"io"
+)
+
+type TodoItem struct {
+	Title string
+	Done  string
+}
+
+func (t *TodoItem) RenderHTML(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "<li>%s %s</li>", t.Title, t.Done)
+	return err
+}
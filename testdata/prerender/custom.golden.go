@@ -0,0 +1,19 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+)
+
+// Custom ...
+type Custom struct{}
+
+// RenderHTML ...
+func (c *Custom) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<sl-dialog class=\"modal\" label=\"Settings\" open=\"\"><sl-input value=\"x\" disabled></sl-input><div>anim</div></sl-dialog>"); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+)
+
+// Document ...
+type Document struct{}
+
+// RenderHTML ...
+func (c *Document) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<body class=\"app\"><h1>Hello</h1><button>Click</button><div id=\"modal\"></div></body>"); err != nil {
+		return err
+	}
+	return nil
+}
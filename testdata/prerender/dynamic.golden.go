@@ -0,0 +1,40 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+
+	"fmt"
+	"html"
+)
+
+// Dynamic ...
+type Dynamic struct{}
+
+// RenderHTML ...
+func (c *Dynamic) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<section class=\"tabs\"><"); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, c.Heading()); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, " class=\"title\">Tabs</"); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, c.Heading()); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, "><p title=\""); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, html.EscapeString(fmt.Sprint(c.Heading()))); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, "\">footer</p></section>"); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,3 @@
+package main
+
+func (c *Dynamic) Heading() string { return "h2" }
@@ -0,0 +1,19 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+)
+
+// Fragment ...
+type Fragment struct{}
+
+// RenderHTML ...
+func (c *Fragment) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<div>Total:<span class=\"count\">3</span><button>Clear</button></div>"); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+)
+
+// Globals ...
+type Globals struct{}
+
+// RenderHTML ...
+func (c *Globals) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<div class=\"dialog\"><button>close</button></div>"); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+)
+
+// Handlers ...
+type Handlers struct{}
+
+// RenderHTML ...
+func (c *Handlers) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<ul class=\"items\"><li><input><input type=\"number\"><input type=\"checkbox\"><input type=\"checkbox\"><button>Remove</button><button>Twice</button><button>Reset</button><button>Save</button></li></ul>"); err != nil {
+		return err
+	}
+	return nil
+}
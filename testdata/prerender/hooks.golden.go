@@ -0,0 +1,19 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+)
+
+// Hooks ...
+type Hooks struct{}
+
+// RenderHTML ...
+func (c *Hooks) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<div class=\"clock\"><span>now</span></div>"); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+)
+
+// List ...
+type List struct{}
+
+// RenderHTML ...
+func (c *List) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "Total:<span class=\"count\">3</span><button>Clear</button>"); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+)
+
+// Mapping ...
+type Mapping struct{}
+
+// RenderHTML ...
+func (c *Mapping) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<card title=\"Settings\" hidden><title>custom title element</title><details>more</details><aside>side</aside></card>"); err != nil {
+		return err
+	}
+	return nil
+}
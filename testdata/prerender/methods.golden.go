@@ -0,0 +1,19 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+)
+
+// Methods ...
+type Methods struct{}
+
+// RenderHTML ...
+func (c *Methods) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<form class=\"dialog\"><input><button>Save</button><button>Cancel</button><a href=\"#\">close</a></form>"); err != nil {
+		return err
+	}
+	return nil
+}
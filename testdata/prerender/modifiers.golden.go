@@ -0,0 +1,19 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+)
+
+// Modifiers ...
+type Modifiers struct{}
+
+// RenderHTML ...
+func (c *Modifiers) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<div class=\"search\"><input type=\"search\"><textarea></textarea><button>Save once</button><div></div><sl-tab-group></sl-tab-group></div>"); err != nil {
+		return err
+	}
+	return nil
+}
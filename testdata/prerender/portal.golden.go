@@ -0,0 +1,19 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+)
+
+// Portal ...
+type Portal struct{}
+
+// RenderHTML ...
+func (c *Portal) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<div class=\"page\"><button>open</button></div>"); err != nil {
+		return err
+	}
+	return nil
+}
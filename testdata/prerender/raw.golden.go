@@ -0,0 +1,25 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+)
+
+// Raw ...
+type Raw struct{}
+
+// RenderHTML ...
+func (c *Raw) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<div><div class=\"legal\"><p>Terms <b>apply</b>.<br>\n    <div>nested <div>deep</div></div></div><section><p>inline</p></section><article>"); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, "<b>bound</b>"); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, "</article><img src=\"/logo.png\"><p>after the void raw element</p><my-tag>custom</my-tag></div>"); err != nil {
+		return err
+	}
+	return nil
+}
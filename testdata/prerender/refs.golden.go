@@ -0,0 +1,19 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+)
+
+// Refs ...
+type Refs struct{}
+
+// RenderHTML ...
+func (c *Refs) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<div class=\"editor\"><input type=\"text\"><canvas width=\"300\" height=\"150\"></canvas></div>"); err != nil {
+		return err
+	}
+	return nil
+}
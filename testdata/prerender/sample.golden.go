@@ -0,0 +1,19 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+)
+
+// Sample ...
+type Sample struct{}
+
+// RenderHTML ...
+func (c *Sample) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<body><input class=\"boo1 boo2 boo3 boo4 boo5\" disabled>Hello<br class=\"hoge\">World!</input><button>Click</button></body>"); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+)
+
+// Scoped ...
+type Scoped struct{}
+
+// RenderHTML ...
+func (c *Scoped) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<style>@import url(\"base.css\");\n  .card[data-v-f1bee550], .card > h2[data-v-f1bee550] { color: red; }\n  .card a[data-v-f1bee550]:hover::after, ul li[data-v-f1bee550]:nth-child(2n+1) { color: blue }\n  @media (max-width: 600px) { .card[data-v-f1bee550] { padding: 0 } }\n  @keyframes spin { from { opacity: 0 } to { opacity: 1 } }</style><div class=\"card\" data-v-f1bee550><h2 data-v-f1bee550>Title</h2><a href=\"#\" data-v-f1bee550>link</a></div>"); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+)
+
+// Spread ...
+type Spread struct{}
+
+// RenderHTML ...
+func (c *Spread) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<div class=\"wrapper\"><button class=\"btn\""); err != nil {
+		return err
+	}
+	if c.Busy() {
+		if _, err := io.WriteString(w, " disabled"); err != nil {
+			return err
+		}
+	}
+	if _, err := io.WriteString(w, ">save</button><span>new</span></div>"); err != nil {
+		return err
+	}
+	return nil
+}
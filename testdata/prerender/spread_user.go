@@ -0,0 +1,3 @@
+package main
+
+func (c *Spread) Busy() bool { return false }
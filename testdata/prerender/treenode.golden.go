@@ -0,0 +1,38 @@
+//go:build !js
+// +build !js
+
+package main
+
+import (
+	"io"
+)
+
+// Treenode ...
+type Treenode struct{
+	Node *TreeData
+	Depth int
+}
+
+// RenderHTML ...
+func (c *Treenode) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<li class=\"node\"><span>node</span>"); err != nil {
+		return err
+	}
+	if c.Node.Open {
+		if _, err := io.WriteString(w, "<ul>"); err != nil {
+			return err
+		}
+		for _, child := range c.Node.Children {
+			if err := (&Treenode{Node: child, Depth: c.Depth + 1}).RenderHTML(w); err != nil {
+				return err
+			}
+		}
+		if _, err := io.WriteString(w, "</ul>"); err != nil {
+			return err
+		}
+	}
+	if _, err := io.WriteString(w, "</li>"); err != nil {
+		return err
+	}
+	return nil
+}
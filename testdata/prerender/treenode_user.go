@@ -0,0 +1,6 @@
+package main
+
+type TreeData struct {
+	Open     bool
+	Children []*TreeData
+}
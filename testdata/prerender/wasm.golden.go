@@ -0,0 +1,19 @@
+//go:build !(js && wasm)
+// +build !js !wasm
+
+package main
+
+import (
+	"io"
+)
+
+// Wasm ...
+type Wasm struct{}
+
+// RenderHTML ...
+func (c *Wasm) RenderHTML(w io.Writer) error {
+	if _, err := io.WriteString(w, "<form><input type=\"checkbox\" checked disabled><button type=\"submit\">Save</button></form>"); err != nil {
+		return err
+	}
+	return nil
+}
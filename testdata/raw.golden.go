@@ -0,0 +1,55 @@
+package main
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/prop"
+)
+
+// NewRaw ...
+func NewRaw(d map[string]func(*vecty.Event)) *Raw {
+	return &Raw{
+		dispatcher: d,
+	}
+}
+
+// Raw ...
+type Raw struct{
+	vecty.Core
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Raw) Render() vecty.ComponentOrHTML {
+	return elem.Div(
+		elem.Div(
+			vecty.Markup(
+				vecty.Class("legal"),
+				vecty.UnsafeHTML(`<p>Terms <b>apply</b>.<br>
+    <div>nested <div>deep</div></div>`),
+			),
+		),
+		elem.Section(
+			vecty.Markup(
+				vecty.UnsafeHTML("<p>inline</p>"),
+			),
+		),
+		elem.Article(
+			vecty.Markup(
+				vecty.UnsafeHTML("<b>bound</b>"),
+			),
+		),
+		elem.Image(
+			vecty.Markup(
+				prop.Src("/logo.png"),
+			),
+		),
+		elem.Paragraph(
+			vecty.Text("after the void raw element"),
+		),
+		vecty.Tag("my-tag", 
+			vecty.Text("custom"),
+		),
+	)
+}
+
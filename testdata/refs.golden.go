@@ -0,0 +1,49 @@
+package main
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/prop"
+)
+
+// NewRefs ...
+func NewRefs(d map[string]func(*vecty.Event)) *Refs {
+	return &Refs{
+		dispatcher: d,
+	}
+}
+
+// Refs ...
+type Refs struct{
+	vecty.Core
+	Name *vecty.HTML
+	Canvas *vecty.HTML
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Refs) Render() vecty.ComponentOrHTML {
+	return elem.Div(
+		vecty.Markup(
+			vecty.Class("editor"),
+		),
+		c.setRef(&c.Name, elem.Input(
+			vecty.Markup(
+				prop.Type("text"),
+			),
+		)),
+		c.setRef(&c.Canvas, elem.Canvas(
+			vecty.Markup(
+				vecty.Property("width", "300"),
+				vecty.Property("height", "150"),
+			),
+		)),
+	)
+}
+
+// setRef keeps the element of a ref field, its Node is set once mounted.
+func (c *Refs) setRef(ref **vecty.HTML, h *vecty.HTML) *vecty.HTML {
+	*ref = h
+	return h
+}
+
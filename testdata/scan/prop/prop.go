@@ -0,0 +1,19 @@
+package prop
+
+import "github.com/gopherjs/vecty"
+
+func Alt(text string) vecty.Applyer {
+	return vecty.Property("alt", text)
+}
+
+func Disabled(disabled bool) vecty.Applyer {
+	return vecty.Property("alt", disabled)
+}
+
+func Checked(checked bool) vecty.Applyer {
+	return vecty.Property("checked", checked)
+}
+
+func For(id string) vecty.Applyer {
+	return vecty.Property("htmlFor", id)
+}
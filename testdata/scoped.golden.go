@@ -0,0 +1,56 @@
+package main
+
+import (
+	"syscall/js"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/prop"
+)
+
+func init() {
+	style := js.Global().Get("document").Call("createElement", "style")
+	style.Set("textContent", `@import url("base.css");
+  .card[data-v-f1bee550], .card > h2[data-v-f1bee550] { color: red; }
+  .card a[data-v-f1bee550]:hover::after, ul li[data-v-f1bee550]:nth-child(2n+1) { color: blue }
+  @media (max-width: 600px) { .card[data-v-f1bee550] { padding: 0 } }
+  @keyframes spin { from { opacity: 0 } to { opacity: 1 } }`)
+	js.Global().Get("document").Get("head").Call("appendChild", style)
+}
+
+// NewScoped ...
+func NewScoped(d map[string]func(*vecty.Event)) *Scoped {
+	return &Scoped{
+		dispatcher: d,
+	}
+}
+
+// Scoped ...
+type Scoped struct{
+	vecty.Core
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Scoped) Render() vecty.ComponentOrHTML {
+	return elem.Div(
+		vecty.Markup(
+			vecty.Class("card"),
+			vecty.Attribute("data-v-f1bee550", ""),
+		),
+		elem.Heading2(
+			vecty.Markup(
+				vecty.Attribute("data-v-f1bee550", ""),
+			),
+			vecty.Text("Title"),
+		),
+		elem.Anchor(
+			vecty.Markup(
+				prop.Href("#"),
+				vecty.Attribute("data-v-f1bee550", ""),
+			),
+			vecty.Text("link"),
+		),
+	)
+}
+
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+)
+
+// NewSpread ...
+func NewSpread(d map[string]func(*vecty.Event)) *Spread {
+	return &Spread{
+		dispatcher: d,
+	}
+}
+
+// Spread ...
+type Spread struct{
+	vecty.Core
+	Markup []vecty.Applyer `vecty:"prop"`
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Spread) Render() vecty.ComponentOrHTML {
+	return elem.Div(
+		vecty.Markup(
+			vecty.Class("wrapper"),
+		),
+		elem.Button(
+			vecty.Markup(append(append([]vecty.Applyer{
+				vecty.Class("btn"),
+			}, c.Markup...),
+				vecty.MarkupIf(c.Busy(),
+					vecty.Property("disabled", true),
+				),
+				vecty.MarkupIf(!c.Busy(),
+					event.Click(c.OnClick),
+				),
+			)...),
+			vecty.Text("save"),
+		),
+		elem.Span(
+			vecty.Markup(c.Badge()...),
+			vecty.Text("new"),
+		),
+	)
+}
+
+// OnClick handles @click on <button>.
+func (c *Spread) OnClick(event *vecty.Event) {
+	f, ok := c.dispatcher["OnClick"]
+	if !ok {
+		panic("unknown func: \"OnClick\"")
+	}
+	f(event)
+}
+
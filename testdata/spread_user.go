@@ -0,0 +1,6 @@
+package main
+
+import "github.com/gopherjs/vecty"
+
+func (c *Spread) Busy() bool              { return false }
+func (c *Spread) Badge() []vecty.Applyer { return nil }
@@ -0,0 +1,20 @@
+// Package widgets is a third party component package used by mapping.json.
+package widgets
+
+import (
+	"io"
+
+	"github.com/gopherjs/vecty"
+)
+
+func Card(markup ...vecty.MarkupOrChild) *vecty.HTML          { return nil }
+func Title(title string) vecty.Applyer                        { return nil }
+func Toggle(listener func(*vecty.Event)) *vecty.EventListener { return nil }
+
+type Panel struct {
+	vecty.Core
+	Heading string
+}
+
+func (p *Panel) Render() vecty.ComponentOrHTML { return nil }
+func (p *Panel) RenderHTML(w io.Writer) error  { return nil }
@@ -0,0 +1,167 @@
+// Package event is a type checking stub of github.com/gopherjs/vecty/event.
+package event
+
+import "github.com/gopherjs/vecty"
+
+func Abort(listener func(*vecty.Event)) *vecty.EventListener                    { return nil }
+func AfterPrint(listener func(*vecty.Event)) *vecty.EventListener               { return nil }
+func AnimationEnd(listener func(*vecty.Event)) *vecty.EventListener             { return nil }
+func AnimationIteration(listener func(*vecty.Event)) *vecty.EventListener       { return nil }
+func AnimationStart(listener func(*vecty.Event)) *vecty.EventListener           { return nil }
+func ApplicationInstalled(listener func(*vecty.Event)) *vecty.EventListener     { return nil }
+func AudioEnd(listener func(*vecty.Event)) *vecty.EventListener                 { return nil }
+func AudioStart(listener func(*vecty.Event)) *vecty.EventListener               { return nil }
+func BeforePrint(listener func(*vecty.Event)) *vecty.EventListener              { return nil }
+func BeforeUnload(listener func(*vecty.Event)) *vecty.EventListener             { return nil }
+func BeginEvent(listener func(*vecty.Event)) *vecty.EventListener               { return nil }
+func Blocked(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func Blur(listener func(*vecty.Event)) *vecty.EventListener                     { return nil }
+func Boundary(listener func(*vecty.Event)) *vecty.EventListener                 { return nil }
+func Cached(listener func(*vecty.Event)) *vecty.EventListener                   { return nil }
+func CanPlay(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func CanPlayThrough(listener func(*vecty.Event)) *vecty.EventListener           { return nil }
+func Change(listener func(*vecty.Event)) *vecty.EventListener                   { return nil }
+func ChargingChange(listener func(*vecty.Event)) *vecty.EventListener           { return nil }
+func ChargingTimeChange(listener func(*vecty.Event)) *vecty.EventListener       { return nil }
+func Checking(listener func(*vecty.Event)) *vecty.EventListener                 { return nil }
+func Click(listener func(*vecty.Event)) *vecty.EventListener                    { return nil }
+func Close(listener func(*vecty.Event)) *vecty.EventListener                    { return nil }
+func Complete(listener func(*vecty.Event)) *vecty.EventListener                 { return nil }
+func CompositionEnd(listener func(*vecty.Event)) *vecty.EventListener           { return nil }
+func CompositionStart(listener func(*vecty.Event)) *vecty.EventListener         { return nil }
+func CompositionUpdate(listener func(*vecty.Event)) *vecty.EventListener        { return nil }
+func ContextMenu(listener func(*vecty.Event)) *vecty.EventListener              { return nil }
+func Copy(listener func(*vecty.Event)) *vecty.EventListener                     { return nil }
+func Cut(listener func(*vecty.Event)) *vecty.EventListener                      { return nil }
+func DOMContentLoaded(listener func(*vecty.Event)) *vecty.EventListener         { return nil }
+func DeviceChange(listener func(*vecty.Event)) *vecty.EventListener             { return nil }
+func DeviceLight(listener func(*vecty.Event)) *vecty.EventListener              { return nil }
+func DeviceMotion(listener func(*vecty.Event)) *vecty.EventListener             { return nil }
+func DeviceOrientation(listener func(*vecty.Event)) *vecty.EventListener        { return nil }
+func DeviceProximity(listener func(*vecty.Event)) *vecty.EventListener          { return nil }
+func DischargingTimeChange(listener func(*vecty.Event)) *vecty.EventListener    { return nil }
+func DoubleClick(listener func(*vecty.Event)) *vecty.EventListener              { return nil }
+func Downloading(listener func(*vecty.Event)) *vecty.EventListener              { return nil }
+func Drag(listener func(*vecty.Event)) *vecty.EventListener                     { return nil }
+func DragEnd(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func DragEnter(listener func(*vecty.Event)) *vecty.EventListener                { return nil }
+func DragLeave(listener func(*vecty.Event)) *vecty.EventListener                { return nil }
+func DragOver(listener func(*vecty.Event)) *vecty.EventListener                 { return nil }
+func DragStart(listener func(*vecty.Event)) *vecty.EventListener                { return nil }
+func Drop(listener func(*vecty.Event)) *vecty.EventListener                     { return nil }
+func DurationChange(listener func(*vecty.Event)) *vecty.EventListener           { return nil }
+func Emptied(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func End(listener func(*vecty.Event)) *vecty.EventListener                      { return nil }
+func EndEvent(listener func(*vecty.Event)) *vecty.EventListener                 { return nil }
+func Ended(listener func(*vecty.Event)) *vecty.EventListener                    { return nil }
+func Error(listener func(*vecty.Event)) *vecty.EventListener                    { return nil }
+func Focus(listener func(*vecty.Event)) *vecty.EventListener                    { return nil }
+func FocusIn(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func FocusOut(listener func(*vecty.Event)) *vecty.EventListener                 { return nil }
+func FullScreenChange(listener func(*vecty.Event)) *vecty.EventListener         { return nil }
+func FullScreenError(listener func(*vecty.Event)) *vecty.EventListener          { return nil }
+func GamepadConnected(listener func(*vecty.Event)) *vecty.EventListener         { return nil }
+func GamepadDisconnected(listener func(*vecty.Event)) *vecty.EventListener      { return nil }
+func GotPointerCapture(listener func(*vecty.Event)) *vecty.EventListener        { return nil }
+func HashChange(listener func(*vecty.Event)) *vecty.EventListener               { return nil }
+func Input(listener func(*vecty.Event)) *vecty.EventListener                    { return nil }
+func Invalid(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func KeyDown(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func KeyPress(listener func(*vecty.Event)) *vecty.EventListener                 { return nil }
+func KeyUp(listener func(*vecty.Event)) *vecty.EventListener                    { return nil }
+func LanguageChange(listener func(*vecty.Event)) *vecty.EventListener           { return nil }
+func LevelChange(listener func(*vecty.Event)) *vecty.EventListener              { return nil }
+func Load(listener func(*vecty.Event)) *vecty.EventListener                     { return nil }
+func LoadEnd(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func LoadStart(listener func(*vecty.Event)) *vecty.EventListener                { return nil }
+func LoadedData(listener func(*vecty.Event)) *vecty.EventListener               { return nil }
+func LoadedMetadata(listener func(*vecty.Event)) *vecty.EventListener           { return nil }
+func LostPointerCapture(listener func(*vecty.Event)) *vecty.EventListener       { return nil }
+func Mark(listener func(*vecty.Event)) *vecty.EventListener                     { return nil }
+func Message(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func MessageError(listener func(*vecty.Event)) *vecty.EventListener             { return nil }
+func MouseDown(listener func(*vecty.Event)) *vecty.EventListener                { return nil }
+func MouseEnter(listener func(*vecty.Event)) *vecty.EventListener               { return nil }
+func MouseLeave(listener func(*vecty.Event)) *vecty.EventListener               { return nil }
+func MouseMove(listener func(*vecty.Event)) *vecty.EventListener                { return nil }
+func MouseOut(listener func(*vecty.Event)) *vecty.EventListener                 { return nil }
+func MouseOver(listener func(*vecty.Event)) *vecty.EventListener                { return nil }
+func MouseUp(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func NoMatch(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func NoUpdate(listener func(*vecty.Event)) *vecty.EventListener                 { return nil }
+func NotificationClick(listener func(*vecty.Event)) *vecty.EventListener        { return nil }
+func Obsolete(listener func(*vecty.Event)) *vecty.EventListener                 { return nil }
+func Offline(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func Online(listener func(*vecty.Event)) *vecty.EventListener                   { return nil }
+func Open(listener func(*vecty.Event)) *vecty.EventListener                     { return nil }
+func OrientationChange(listener func(*vecty.Event)) *vecty.EventListener        { return nil }
+func PageHide(listener func(*vecty.Event)) *vecty.EventListener                 { return nil }
+func PageShow(listener func(*vecty.Event)) *vecty.EventListener                 { return nil }
+func Paste(listener func(*vecty.Event)) *vecty.EventListener                    { return nil }
+func Pause(listener func(*vecty.Event)) *vecty.EventListener                    { return nil }
+func Play(listener func(*vecty.Event)) *vecty.EventListener                     { return nil }
+func Playing(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func PointerCancel(listener func(*vecty.Event)) *vecty.EventListener            { return nil }
+func PointerDown(listener func(*vecty.Event)) *vecty.EventListener              { return nil }
+func PointerEnter(listener func(*vecty.Event)) *vecty.EventListener             { return nil }
+func PointerLeave(listener func(*vecty.Event)) *vecty.EventListener             { return nil }
+func PointerLockChange(listener func(*vecty.Event)) *vecty.EventListener        { return nil }
+func PointerLockError(listener func(*vecty.Event)) *vecty.EventListener         { return nil }
+func PointerMove(listener func(*vecty.Event)) *vecty.EventListener              { return nil }
+func PointerOut(listener func(*vecty.Event)) *vecty.EventListener               { return nil }
+func PointerOver(listener func(*vecty.Event)) *vecty.EventListener              { return nil }
+func PointerUp(listener func(*vecty.Event)) *vecty.EventListener                { return nil }
+func PopState(listener func(*vecty.Event)) *vecty.EventListener                 { return nil }
+func Progress(listener func(*vecty.Event)) *vecty.EventListener                 { return nil }
+func Push(listener func(*vecty.Event)) *vecty.EventListener                     { return nil }
+func PushSubscriptionChange(listener func(*vecty.Event)) *vecty.EventListener   { return nil }
+func RateChange(listener func(*vecty.Event)) *vecty.EventListener               { return nil }
+func ReadyStateChange(listener func(*vecty.Event)) *vecty.EventListener         { return nil }
+func RepeatEvent(listener func(*vecty.Event)) *vecty.EventListener              { return nil }
+func Reset(listener func(*vecty.Event)) *vecty.EventListener                    { return nil }
+func Resize(listener func(*vecty.Event)) *vecty.EventListener                   { return nil }
+func ResourceTimingBufferFull(listener func(*vecty.Event)) *vecty.EventListener { return nil }
+func Result(listener func(*vecty.Event)) *vecty.EventListener                   { return nil }
+func Resume(listener func(*vecty.Event)) *vecty.EventListener                   { return nil }
+func SVGAbort(listener func(*vecty.Event)) *vecty.EventListener                 { return nil }
+func SVGError(listener func(*vecty.Event)) *vecty.EventListener                 { return nil }
+func SVGLoad(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func SVGResize(listener func(*vecty.Event)) *vecty.EventListener                { return nil }
+func SVGScroll(listener func(*vecty.Event)) *vecty.EventListener                { return nil }
+func SVGUnload(listener func(*vecty.Event)) *vecty.EventListener                { return nil }
+func SVGZoom(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func Scroll(listener func(*vecty.Event)) *vecty.EventListener                   { return nil }
+func Seeked(listener func(*vecty.Event)) *vecty.EventListener                   { return nil }
+func Seeking(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func Select(listener func(*vecty.Event)) *vecty.EventListener                   { return nil }
+func SelectStart(listener func(*vecty.Event)) *vecty.EventListener              { return nil }
+func SelectionChange(listener func(*vecty.Event)) *vecty.EventListener          { return nil }
+func Show(listener func(*vecty.Event)) *vecty.EventListener                     { return nil }
+func SlotChange(listener func(*vecty.Event)) *vecty.EventListener               { return nil }
+func SoundEnd(listener func(*vecty.Event)) *vecty.EventListener                 { return nil }
+func SoundStart(listener func(*vecty.Event)) *vecty.EventListener               { return nil }
+func SpeechEnd(listener func(*vecty.Event)) *vecty.EventListener                { return nil }
+func SpeechStart(listener func(*vecty.Event)) *vecty.EventListener              { return nil }
+func Stalled(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func Start(listener func(*vecty.Event)) *vecty.EventListener                    { return nil }
+func Storage(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func Submit(listener func(*vecty.Event)) *vecty.EventListener                   { return nil }
+func Success(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func Suspend(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func TimeUpdate(listener func(*vecty.Event)) *vecty.EventListener               { return nil }
+func Timeout(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func TouchCancel(listener func(*vecty.Event)) *vecty.EventListener              { return nil }
+func TouchEnd(listener func(*vecty.Event)) *vecty.EventListener                 { return nil }
+func TouchMove(listener func(*vecty.Event)) *vecty.EventListener                { return nil }
+func TouchStart(listener func(*vecty.Event)) *vecty.EventListener               { return nil }
+func TransitionEnd(listener func(*vecty.Event)) *vecty.EventListener            { return nil }
+func Unload(listener func(*vecty.Event)) *vecty.EventListener                   { return nil }
+func UpdateReady(listener func(*vecty.Event)) *vecty.EventListener              { return nil }
+func UpgradeNeeded(listener func(*vecty.Event)) *vecty.EventListener            { return nil }
+func UserProximity(listener func(*vecty.Event)) *vecty.EventListener            { return nil }
+func VersionChange(listener func(*vecty.Event)) *vecty.EventListener            { return nil }
+func VisibilityChange(listener func(*vecty.Event)) *vecty.EventListener         { return nil }
+func VoicesChanged(listener func(*vecty.Event)) *vecty.EventListener            { return nil }
+func VolumeChange(listener func(*vecty.Event)) *vecty.EventListener             { return nil }
+func Waiting(listener func(*vecty.Event)) *vecty.EventListener                  { return nil }
+func Wheel(listener func(*vecty.Event)) *vecty.EventListener                    { return nil }
@@ -0,0 +1,113 @@
+// Package vecty is a type checking stub of github.com/gopherjs/vecty.
+package vecty
+
+import "syscall/js"
+
+type Core struct{}
+
+func (c *Core) Context() *Core     { return c }
+func (c *Core) isMarkupOrChild()   {}
+func (c *Core) isComponentOrHTML() {}
+
+type Component interface {
+	Render() ComponentOrHTML
+	Context() *Core
+	isComponentOrHTML()
+	isMarkupOrChild()
+}
+
+type Copier interface {
+	Copy() Component
+}
+
+type Mounter interface {
+	Mount()
+}
+
+type Unmounter interface {
+	Unmount()
+}
+
+type Keyer interface {
+	Key() interface{}
+}
+
+type ComponentOrHTML interface {
+	isComponentOrHTML()
+	isMarkupOrChild()
+}
+
+type RenderSkipper interface {
+	SkipRender(prev Component) bool
+}
+
+type HTML struct{}
+
+func (h *HTML) Key() interface{}   { return nil }
+func (h *HTML) Node() js.Value     { return js.Value{} }
+func (h *HTML) isMarkupOrChild()   {}
+func (h *HTML) isComponentOrHTML() {}
+
+type List []ComponentOrHTML
+
+func (l List) isMarkupOrChild()                  {}
+func (l List) isComponentOrHTML()                {}
+func (l List) WithKey(key interface{}) KeyedList { return KeyedList{} }
+
+type KeyedList struct{}
+
+func (l KeyedList) isMarkupOrChild()   {}
+func (l KeyedList) isComponentOrHTML() {}
+func (l KeyedList) Key() interface{}   { return nil }
+
+func Tag(tag string, m ...MarkupOrChild) *HTML      { return nil }
+func Text(text string, m ...MarkupOrChild) *HTML    { return nil }
+func Rerender(c Component)                          {}
+func RenderBody(body Component)                     {}
+func RenderInto(selector string, c Component) error { return nil }
+func SetTitle(title string)                         {}
+func AddStylesheet(url string)                      {}
+
+type Event struct {
+	js.Value
+	Target js.Value
+}
+
+type EventListener struct {
+	Name     string
+	Listener func(*Event)
+}
+
+func (l *EventListener) PreventDefault() *EventListener  { return l }
+func (l *EventListener) StopPropagation() *EventListener { return l }
+func (l *EventListener) Apply(h *HTML)                   {}
+
+type MarkupOrChild interface {
+	isMarkupOrChild()
+}
+
+type Applyer interface {
+	Apply(h *HTML)
+}
+
+func Style(key, value string) Applyer                 { return nil }
+func Key(key interface{}) Applyer                     { return nil }
+func Property(key string, value interface{}) Applyer  { return nil }
+func Attribute(key string, value interface{}) Applyer { return nil }
+func Data(key, value string) Applyer                  { return nil }
+func Class(class ...string) Applyer                   { return nil }
+
+type ClassMap map[string]bool
+
+func (m ClassMap) Apply(h *HTML) {}
+
+type MarkupList struct{}
+
+func (m MarkupList) Apply(h *HTML)    {}
+func (m MarkupList) isMarkupOrChild() {}
+
+func Markup(m ...Applyer) MarkupList                          { return MarkupList{} }
+func If(cond bool, children ...ComponentOrHTML) MarkupOrChild { return nil }
+func MarkupIf(cond bool, markup ...Applyer) Applyer           { return nil }
+func UnsafeHTML(html string) Applyer                          { return nil }
+func Namespace(uri string) Applyer                            { return nil }
@@ -0,0 +1,116 @@
+// Package elem is a type checking stub of github.com/hexops/vecty/elem.
+package elem
+
+import "github.com/hexops/vecty"
+
+func Anchor(markup ...vecty.MarkupOrChild) *vecty.HTML                 { return nil }
+func Abbreviation(markup ...vecty.MarkupOrChild) *vecty.HTML           { return nil }
+func Address(markup ...vecty.MarkupOrChild) *vecty.HTML                { return nil }
+func Area(markup ...vecty.MarkupOrChild) *vecty.HTML                   { return nil }
+func Article(markup ...vecty.MarkupOrChild) *vecty.HTML                { return nil }
+func Aside(markup ...vecty.MarkupOrChild) *vecty.HTML                  { return nil }
+func Audio(markup ...vecty.MarkupOrChild) *vecty.HTML                  { return nil }
+func Bold(markup ...vecty.MarkupOrChild) *vecty.HTML                   { return nil }
+func Base(markup ...vecty.MarkupOrChild) *vecty.HTML                   { return nil }
+func BidirectionalIsolation(markup ...vecty.MarkupOrChild) *vecty.HTML { return nil }
+func BidirectionalOverride(markup ...vecty.MarkupOrChild) *vecty.HTML  { return nil }
+func BlockQuote(markup ...vecty.MarkupOrChild) *vecty.HTML             { return nil }
+func Body(markup ...vecty.MarkupOrChild) *vecty.HTML                   { return nil }
+func Break(markup ...vecty.MarkupOrChild) *vecty.HTML                  { return nil }
+func Button(markup ...vecty.MarkupOrChild) *vecty.HTML                 { return nil }
+func Canvas(markup ...vecty.MarkupOrChild) *vecty.HTML                 { return nil }
+func Caption(markup ...vecty.MarkupOrChild) *vecty.HTML                { return nil }
+func Citation(markup ...vecty.MarkupOrChild) *vecty.HTML               { return nil }
+func Code(markup ...vecty.MarkupOrChild) *vecty.HTML                   { return nil }
+func Column(markup ...vecty.MarkupOrChild) *vecty.HTML                 { return nil }
+func ColumnGroup(markup ...vecty.MarkupOrChild) *vecty.HTML            { return nil }
+func Data(markup ...vecty.MarkupOrChild) *vecty.HTML                   { return nil }
+func DataList(markup ...vecty.MarkupOrChild) *vecty.HTML               { return nil }
+func Description(markup ...vecty.MarkupOrChild) *vecty.HTML            { return nil }
+func DeletedText(markup ...vecty.MarkupOrChild) *vecty.HTML            { return nil }
+func Details(markup ...vecty.MarkupOrChild) *vecty.HTML                { return nil }
+func Definition(markup ...vecty.MarkupOrChild) *vecty.HTML             { return nil }
+func Dialog(markup ...vecty.MarkupOrChild) *vecty.HTML                 { return nil }
+func Div(markup ...vecty.MarkupOrChild) *vecty.HTML                    { return nil }
+func DescriptionList(markup ...vecty.MarkupOrChild) *vecty.HTML        { return nil }
+func DefinitionTerm(markup ...vecty.MarkupOrChild) *vecty.HTML         { return nil }
+func Emphasis(markup ...vecty.MarkupOrChild) *vecty.HTML               { return nil }
+func Embed(markup ...vecty.MarkupOrChild) *vecty.HTML                  { return nil }
+func FieldSet(markup ...vecty.MarkupOrChild) *vecty.HTML               { return nil }
+func FigureCaption(markup ...vecty.MarkupOrChild) *vecty.HTML          { return nil }
+func Figure(markup ...vecty.MarkupOrChild) *vecty.HTML                 { return nil }
+func Footer(markup ...vecty.MarkupOrChild) *vecty.HTML                 { return nil }
+func Form(markup ...vecty.MarkupOrChild) *vecty.HTML                   { return nil }
+func Heading1(markup ...vecty.MarkupOrChild) *vecty.HTML               { return nil }
+func Heading2(markup ...vecty.MarkupOrChild) *vecty.HTML               { return nil }
+func Heading3(markup ...vecty.MarkupOrChild) *vecty.HTML               { return nil }
+func Heading4(markup ...vecty.MarkupOrChild) *vecty.HTML               { return nil }
+func Heading5(markup ...vecty.MarkupOrChild) *vecty.HTML               { return nil }
+func Heading6(markup ...vecty.MarkupOrChild) *vecty.HTML               { return nil }
+func Header(markup ...vecty.MarkupOrChild) *vecty.HTML                 { return nil }
+func HeadingsGroup(markup ...vecty.MarkupOrChild) *vecty.HTML          { return nil }
+func HorizontalRule(markup ...vecty.MarkupOrChild) *vecty.HTML         { return nil }
+func Italic(markup ...vecty.MarkupOrChild) *vecty.HTML                 { return nil }
+func InlineFrame(markup ...vecty.MarkupOrChild) *vecty.HTML            { return nil }
+func Image(markup ...vecty.MarkupOrChild) *vecty.HTML                  { return nil }
+func Input(markup ...vecty.MarkupOrChild) *vecty.HTML                  { return nil }
+func InsertedText(markup ...vecty.MarkupOrChild) *vecty.HTML           { return nil }
+func KeyboardInput(markup ...vecty.MarkupOrChild) *vecty.HTML          { return nil }
+func Label(markup ...vecty.MarkupOrChild) *vecty.HTML                  { return nil }
+func Legend(markup ...vecty.MarkupOrChild) *vecty.HTML                 { return nil }
+func ListItem(markup ...vecty.MarkupOrChild) *vecty.HTML               { return nil }
+func Link(markup ...vecty.MarkupOrChild) *vecty.HTML                   { return nil }
+func Main(markup ...vecty.MarkupOrChild) *vecty.HTML                   { return nil }
+func Map(markup ...vecty.MarkupOrChild) *vecty.HTML                    { return nil }
+func Mark(markup ...vecty.MarkupOrChild) *vecty.HTML                   { return nil }
+func Menu(markup ...vecty.MarkupOrChild) *vecty.HTML                   { return nil }
+func Meta(markup ...vecty.MarkupOrChild) *vecty.HTML                   { return nil }
+func Meter(markup ...vecty.MarkupOrChild) *vecty.HTML                  { return nil }
+func Navigation(markup ...vecty.MarkupOrChild) *vecty.HTML             { return nil }
+func NoScript(markup ...vecty.MarkupOrChild) *vecty.HTML               { return nil }
+func Object(markup ...vecty.MarkupOrChild) *vecty.HTML                 { return nil }
+func OrderedList(markup ...vecty.MarkupOrChild) *vecty.HTML            { return nil }
+func OptionsGroup(markup ...vecty.MarkupOrChild) *vecty.HTML           { return nil }
+func Option(markup ...vecty.MarkupOrChild) *vecty.HTML                 { return nil }
+func Output(markup ...vecty.MarkupOrChild) *vecty.HTML                 { return nil }
+func Paragraph(markup ...vecty.MarkupOrChild) *vecty.HTML              { return nil }
+func Parameter(markup ...vecty.MarkupOrChild) *vecty.HTML              { return nil }
+func Picture(markup ...vecty.MarkupOrChild) *vecty.HTML                { return nil }
+func Preformatted(markup ...vecty.MarkupOrChild) *vecty.HTML           { return nil }
+func Progress(markup ...vecty.MarkupOrChild) *vecty.HTML               { return nil }
+func Quote(markup ...vecty.MarkupOrChild) *vecty.HTML                  { return nil }
+func RubyParenthesis(markup ...vecty.MarkupOrChild) *vecty.HTML        { return nil }
+func RubyText(markup ...vecty.MarkupOrChild) *vecty.HTML               { return nil }
+func RubyTextContainer(markup ...vecty.MarkupOrChild) *vecty.HTML      { return nil }
+func Ruby(markup ...vecty.MarkupOrChild) *vecty.HTML                   { return nil }
+func Strikethrough(markup ...vecty.MarkupOrChild) *vecty.HTML          { return nil }
+func Sample(markup ...vecty.MarkupOrChild) *vecty.HTML                 { return nil }
+func Script(markup ...vecty.MarkupOrChild) *vecty.HTML                 { return nil }
+func Section(markup ...vecty.MarkupOrChild) *vecty.HTML                { return nil }
+func Select(markup ...vecty.MarkupOrChild) *vecty.HTML                 { return nil }
+func Slot(markup ...vecty.MarkupOrChild) *vecty.HTML                   { return nil }
+func Small(markup ...vecty.MarkupOrChild) *vecty.HTML                  { return nil }
+func Source(markup ...vecty.MarkupOrChild) *vecty.HTML                 { return nil }
+func Span(markup ...vecty.MarkupOrChild) *vecty.HTML                   { return nil }
+func Strong(markup ...vecty.MarkupOrChild) *vecty.HTML                 { return nil }
+func Style(markup ...vecty.MarkupOrChild) *vecty.HTML                  { return nil }
+func Subscript(markup ...vecty.MarkupOrChild) *vecty.HTML              { return nil }
+func Summary(markup ...vecty.MarkupOrChild) *vecty.HTML                { return nil }
+func Superscript(markup ...vecty.MarkupOrChild) *vecty.HTML            { return nil }
+func Table(markup ...vecty.MarkupOrChild) *vecty.HTML                  { return nil }
+func TableBody(markup ...vecty.MarkupOrChild) *vecty.HTML              { return nil }
+func TableData(markup ...vecty.MarkupOrChild) *vecty.HTML              { return nil }
+func Template(markup ...vecty.MarkupOrChild) *vecty.HTML               { return nil }
+func TextArea(markup ...vecty.MarkupOrChild) *vecty.HTML               { return nil }
+func TableFoot(markup ...vecty.MarkupOrChild) *vecty.HTML              { return nil }
+func TableHeader(markup ...vecty.MarkupOrChild) *vecty.HTML            { return nil }
+func TableHead(markup ...vecty.MarkupOrChild) *vecty.HTML              { return nil }
+func Time(markup ...vecty.MarkupOrChild) *vecty.HTML                   { return nil }
+func Title(markup ...vecty.MarkupOrChild) *vecty.HTML                  { return nil }
+func TableRow(markup ...vecty.MarkupOrChild) *vecty.HTML               { return nil }
+func Track(markup ...vecty.MarkupOrChild) *vecty.HTML                  { return nil }
+func Underline(markup ...vecty.MarkupOrChild) *vecty.HTML              { return nil }
+func UnorderedList(markup ...vecty.MarkupOrChild) *vecty.HTML          { return nil }
+func Variable(markup ...vecty.MarkupOrChild) *vecty.HTML               { return nil }
+func Video(markup ...vecty.MarkupOrChild) *vecty.HTML                  { return nil }
+func WordBreakOpportunity(markup ...vecty.MarkupOrChild) *vecty.HTML   { return nil }
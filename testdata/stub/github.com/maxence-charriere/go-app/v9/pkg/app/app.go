@@ -0,0 +1,1209 @@
+// Package app is a go-app stub, every element constructor returns the
+// interface of its element as in go-app.
+package app
+
+type UI interface{}
+
+type Context interface{}
+
+type Value interface {
+	Bool() bool
+	Call(m string, args ...interface{}) Value
+	Get(p string) Value
+	String() string
+}
+
+type Event struct{ Value }
+
+type EventHandler func(ctx Context, e Event)
+
+type Compo struct{}
+
+func Text(v interface{}) UI { return nil }
+func Raw(v string) UI       { return nil }
+
+type HTMLElem interface {
+	UI
+	Attr(n string, v interface{}) HTMLElem
+	Body(elems ...UI) HTMLElem
+	Class(v ...string) HTMLElem
+	ID(v string) HTMLElem
+	On(event string, h EventHandler, scope ...interface{}) HTMLElem
+}
+
+func Elem(tag string) HTMLElem { return nil }
+
+type HTMLA interface {
+	UI
+	Attr(n string, v interface{}) HTMLA
+	Body(elems ...UI) HTMLA
+	Class(v ...string) HTMLA
+	ID(v string) HTMLA
+	On(event string, h EventHandler, scope ...interface{}) HTMLA
+}
+
+func A() HTMLA { return nil }
+
+type HTMLAbbr interface {
+	UI
+	Attr(n string, v interface{}) HTMLAbbr
+	Body(elems ...UI) HTMLAbbr
+	Class(v ...string) HTMLAbbr
+	ID(v string) HTMLAbbr
+	On(event string, h EventHandler, scope ...interface{}) HTMLAbbr
+}
+
+func Abbr() HTMLAbbr { return nil }
+
+type HTMLAddress interface {
+	UI
+	Attr(n string, v interface{}) HTMLAddress
+	Body(elems ...UI) HTMLAddress
+	Class(v ...string) HTMLAddress
+	ID(v string) HTMLAddress
+	On(event string, h EventHandler, scope ...interface{}) HTMLAddress
+}
+
+func Address() HTMLAddress { return nil }
+
+type HTMLArea interface {
+	UI
+	Attr(n string, v interface{}) HTMLArea
+	Class(v ...string) HTMLArea
+	ID(v string) HTMLArea
+	On(event string, h EventHandler, scope ...interface{}) HTMLArea
+}
+
+func Area() HTMLArea { return nil }
+
+type HTMLArticle interface {
+	UI
+	Attr(n string, v interface{}) HTMLArticle
+	Body(elems ...UI) HTMLArticle
+	Class(v ...string) HTMLArticle
+	ID(v string) HTMLArticle
+	On(event string, h EventHandler, scope ...interface{}) HTMLArticle
+}
+
+func Article() HTMLArticle { return nil }
+
+type HTMLAside interface {
+	UI
+	Attr(n string, v interface{}) HTMLAside
+	Body(elems ...UI) HTMLAside
+	Class(v ...string) HTMLAside
+	ID(v string) HTMLAside
+	On(event string, h EventHandler, scope ...interface{}) HTMLAside
+}
+
+func Aside() HTMLAside { return nil }
+
+type HTMLAudio interface {
+	UI
+	Attr(n string, v interface{}) HTMLAudio
+	Body(elems ...UI) HTMLAudio
+	Class(v ...string) HTMLAudio
+	ID(v string) HTMLAudio
+	On(event string, h EventHandler, scope ...interface{}) HTMLAudio
+}
+
+func Audio() HTMLAudio { return nil }
+
+type HTMLB interface {
+	UI
+	Attr(n string, v interface{}) HTMLB
+	Body(elems ...UI) HTMLB
+	Class(v ...string) HTMLB
+	ID(v string) HTMLB
+	On(event string, h EventHandler, scope ...interface{}) HTMLB
+}
+
+func B() HTMLB { return nil }
+
+type HTMLBase interface {
+	UI
+	Attr(n string, v interface{}) HTMLBase
+	Class(v ...string) HTMLBase
+	ID(v string) HTMLBase
+	On(event string, h EventHandler, scope ...interface{}) HTMLBase
+}
+
+func Base() HTMLBase { return nil }
+
+type HTMLBdi interface {
+	UI
+	Attr(n string, v interface{}) HTMLBdi
+	Body(elems ...UI) HTMLBdi
+	Class(v ...string) HTMLBdi
+	ID(v string) HTMLBdi
+	On(event string, h EventHandler, scope ...interface{}) HTMLBdi
+}
+
+func Bdi() HTMLBdi { return nil }
+
+type HTMLBdo interface {
+	UI
+	Attr(n string, v interface{}) HTMLBdo
+	Body(elems ...UI) HTMLBdo
+	Class(v ...string) HTMLBdo
+	ID(v string) HTMLBdo
+	On(event string, h EventHandler, scope ...interface{}) HTMLBdo
+}
+
+func Bdo() HTMLBdo { return nil }
+
+type HTMLBlockquote interface {
+	UI
+	Attr(n string, v interface{}) HTMLBlockquote
+	Body(elems ...UI) HTMLBlockquote
+	Class(v ...string) HTMLBlockquote
+	ID(v string) HTMLBlockquote
+	On(event string, h EventHandler, scope ...interface{}) HTMLBlockquote
+}
+
+func Blockquote() HTMLBlockquote { return nil }
+
+type HTMLBody interface {
+	UI
+	Attr(n string, v interface{}) HTMLBody
+	Body(elems ...UI) HTMLBody
+	Class(v ...string) HTMLBody
+	ID(v string) HTMLBody
+	On(event string, h EventHandler, scope ...interface{}) HTMLBody
+}
+
+func Body() HTMLBody { return nil }
+
+type HTMLBr interface {
+	UI
+	Attr(n string, v interface{}) HTMLBr
+	Class(v ...string) HTMLBr
+	ID(v string) HTMLBr
+	On(event string, h EventHandler, scope ...interface{}) HTMLBr
+}
+
+func Br() HTMLBr { return nil }
+
+type HTMLButton interface {
+	UI
+	Attr(n string, v interface{}) HTMLButton
+	Body(elems ...UI) HTMLButton
+	Class(v ...string) HTMLButton
+	ID(v string) HTMLButton
+	On(event string, h EventHandler, scope ...interface{}) HTMLButton
+}
+
+func Button() HTMLButton { return nil }
+
+type HTMLCanvas interface {
+	UI
+	Attr(n string, v interface{}) HTMLCanvas
+	Body(elems ...UI) HTMLCanvas
+	Class(v ...string) HTMLCanvas
+	ID(v string) HTMLCanvas
+	On(event string, h EventHandler, scope ...interface{}) HTMLCanvas
+}
+
+func Canvas() HTMLCanvas { return nil }
+
+type HTMLCaption interface {
+	UI
+	Attr(n string, v interface{}) HTMLCaption
+	Body(elems ...UI) HTMLCaption
+	Class(v ...string) HTMLCaption
+	ID(v string) HTMLCaption
+	On(event string, h EventHandler, scope ...interface{}) HTMLCaption
+}
+
+func Caption() HTMLCaption { return nil }
+
+type HTMLCite interface {
+	UI
+	Attr(n string, v interface{}) HTMLCite
+	Body(elems ...UI) HTMLCite
+	Class(v ...string) HTMLCite
+	ID(v string) HTMLCite
+	On(event string, h EventHandler, scope ...interface{}) HTMLCite
+}
+
+func Cite() HTMLCite { return nil }
+
+type HTMLCode interface {
+	UI
+	Attr(n string, v interface{}) HTMLCode
+	Body(elems ...UI) HTMLCode
+	Class(v ...string) HTMLCode
+	ID(v string) HTMLCode
+	On(event string, h EventHandler, scope ...interface{}) HTMLCode
+}
+
+func Code() HTMLCode { return nil }
+
+type HTMLCol interface {
+	UI
+	Attr(n string, v interface{}) HTMLCol
+	Class(v ...string) HTMLCol
+	ID(v string) HTMLCol
+	On(event string, h EventHandler, scope ...interface{}) HTMLCol
+}
+
+func Col() HTMLCol { return nil }
+
+type HTMLColGroup interface {
+	UI
+	Attr(n string, v interface{}) HTMLColGroup
+	Body(elems ...UI) HTMLColGroup
+	Class(v ...string) HTMLColGroup
+	ID(v string) HTMLColGroup
+	On(event string, h EventHandler, scope ...interface{}) HTMLColGroup
+}
+
+func ColGroup() HTMLColGroup { return nil }
+
+type HTMLData interface {
+	UI
+	Attr(n string, v interface{}) HTMLData
+	Body(elems ...UI) HTMLData
+	Class(v ...string) HTMLData
+	ID(v string) HTMLData
+	On(event string, h EventHandler, scope ...interface{}) HTMLData
+}
+
+func Data() HTMLData { return nil }
+
+type HTMLDataList interface {
+	UI
+	Attr(n string, v interface{}) HTMLDataList
+	Body(elems ...UI) HTMLDataList
+	Class(v ...string) HTMLDataList
+	ID(v string) HTMLDataList
+	On(event string, h EventHandler, scope ...interface{}) HTMLDataList
+}
+
+func DataList() HTMLDataList { return nil }
+
+type HTMLDd interface {
+	UI
+	Attr(n string, v interface{}) HTMLDd
+	Body(elems ...UI) HTMLDd
+	Class(v ...string) HTMLDd
+	ID(v string) HTMLDd
+	On(event string, h EventHandler, scope ...interface{}) HTMLDd
+}
+
+func Dd() HTMLDd { return nil }
+
+type HTMLDel interface {
+	UI
+	Attr(n string, v interface{}) HTMLDel
+	Body(elems ...UI) HTMLDel
+	Class(v ...string) HTMLDel
+	ID(v string) HTMLDel
+	On(event string, h EventHandler, scope ...interface{}) HTMLDel
+}
+
+func Del() HTMLDel { return nil }
+
+type HTMLDetails interface {
+	UI
+	Attr(n string, v interface{}) HTMLDetails
+	Body(elems ...UI) HTMLDetails
+	Class(v ...string) HTMLDetails
+	ID(v string) HTMLDetails
+	On(event string, h EventHandler, scope ...interface{}) HTMLDetails
+}
+
+func Details() HTMLDetails { return nil }
+
+type HTMLDfn interface {
+	UI
+	Attr(n string, v interface{}) HTMLDfn
+	Body(elems ...UI) HTMLDfn
+	Class(v ...string) HTMLDfn
+	ID(v string) HTMLDfn
+	On(event string, h EventHandler, scope ...interface{}) HTMLDfn
+}
+
+func Dfn() HTMLDfn { return nil }
+
+type HTMLDialog interface {
+	UI
+	Attr(n string, v interface{}) HTMLDialog
+	Body(elems ...UI) HTMLDialog
+	Class(v ...string) HTMLDialog
+	ID(v string) HTMLDialog
+	On(event string, h EventHandler, scope ...interface{}) HTMLDialog
+}
+
+func Dialog() HTMLDialog { return nil }
+
+type HTMLDiv interface {
+	UI
+	Attr(n string, v interface{}) HTMLDiv
+	Body(elems ...UI) HTMLDiv
+	Class(v ...string) HTMLDiv
+	ID(v string) HTMLDiv
+	On(event string, h EventHandler, scope ...interface{}) HTMLDiv
+}
+
+func Div() HTMLDiv { return nil }
+
+type HTMLDl interface {
+	UI
+	Attr(n string, v interface{}) HTMLDl
+	Body(elems ...UI) HTMLDl
+	Class(v ...string) HTMLDl
+	ID(v string) HTMLDl
+	On(event string, h EventHandler, scope ...interface{}) HTMLDl
+}
+
+func Dl() HTMLDl { return nil }
+
+type HTMLDt interface {
+	UI
+	Attr(n string, v interface{}) HTMLDt
+	Body(elems ...UI) HTMLDt
+	Class(v ...string) HTMLDt
+	ID(v string) HTMLDt
+	On(event string, h EventHandler, scope ...interface{}) HTMLDt
+}
+
+func Dt() HTMLDt { return nil }
+
+type HTMLEm interface {
+	UI
+	Attr(n string, v interface{}) HTMLEm
+	Body(elems ...UI) HTMLEm
+	Class(v ...string) HTMLEm
+	ID(v string) HTMLEm
+	On(event string, h EventHandler, scope ...interface{}) HTMLEm
+}
+
+func Em() HTMLEm { return nil }
+
+type HTMLEmbed interface {
+	UI
+	Attr(n string, v interface{}) HTMLEmbed
+	Class(v ...string) HTMLEmbed
+	ID(v string) HTMLEmbed
+	On(event string, h EventHandler, scope ...interface{}) HTMLEmbed
+}
+
+func Embed() HTMLEmbed { return nil }
+
+type HTMLFieldSet interface {
+	UI
+	Attr(n string, v interface{}) HTMLFieldSet
+	Body(elems ...UI) HTMLFieldSet
+	Class(v ...string) HTMLFieldSet
+	ID(v string) HTMLFieldSet
+	On(event string, h EventHandler, scope ...interface{}) HTMLFieldSet
+}
+
+func FieldSet() HTMLFieldSet { return nil }
+
+type HTMLFigCaption interface {
+	UI
+	Attr(n string, v interface{}) HTMLFigCaption
+	Body(elems ...UI) HTMLFigCaption
+	Class(v ...string) HTMLFigCaption
+	ID(v string) HTMLFigCaption
+	On(event string, h EventHandler, scope ...interface{}) HTMLFigCaption
+}
+
+func FigCaption() HTMLFigCaption { return nil }
+
+type HTMLFigure interface {
+	UI
+	Attr(n string, v interface{}) HTMLFigure
+	Body(elems ...UI) HTMLFigure
+	Class(v ...string) HTMLFigure
+	ID(v string) HTMLFigure
+	On(event string, h EventHandler, scope ...interface{}) HTMLFigure
+}
+
+func Figure() HTMLFigure { return nil }
+
+type HTMLFooter interface {
+	UI
+	Attr(n string, v interface{}) HTMLFooter
+	Body(elems ...UI) HTMLFooter
+	Class(v ...string) HTMLFooter
+	ID(v string) HTMLFooter
+	On(event string, h EventHandler, scope ...interface{}) HTMLFooter
+}
+
+func Footer() HTMLFooter { return nil }
+
+type HTMLForm interface {
+	UI
+	Attr(n string, v interface{}) HTMLForm
+	Body(elems ...UI) HTMLForm
+	Class(v ...string) HTMLForm
+	ID(v string) HTMLForm
+	On(event string, h EventHandler, scope ...interface{}) HTMLForm
+}
+
+func Form() HTMLForm { return nil }
+
+type HTMLH1 interface {
+	UI
+	Attr(n string, v interface{}) HTMLH1
+	Body(elems ...UI) HTMLH1
+	Class(v ...string) HTMLH1
+	ID(v string) HTMLH1
+	On(event string, h EventHandler, scope ...interface{}) HTMLH1
+}
+
+func H1() HTMLH1 { return nil }
+
+type HTMLH2 interface {
+	UI
+	Attr(n string, v interface{}) HTMLH2
+	Body(elems ...UI) HTMLH2
+	Class(v ...string) HTMLH2
+	ID(v string) HTMLH2
+	On(event string, h EventHandler, scope ...interface{}) HTMLH2
+}
+
+func H2() HTMLH2 { return nil }
+
+type HTMLH3 interface {
+	UI
+	Attr(n string, v interface{}) HTMLH3
+	Body(elems ...UI) HTMLH3
+	Class(v ...string) HTMLH3
+	ID(v string) HTMLH3
+	On(event string, h EventHandler, scope ...interface{}) HTMLH3
+}
+
+func H3() HTMLH3 { return nil }
+
+type HTMLH4 interface {
+	UI
+	Attr(n string, v interface{}) HTMLH4
+	Body(elems ...UI) HTMLH4
+	Class(v ...string) HTMLH4
+	ID(v string) HTMLH4
+	On(event string, h EventHandler, scope ...interface{}) HTMLH4
+}
+
+func H4() HTMLH4 { return nil }
+
+type HTMLH5 interface {
+	UI
+	Attr(n string, v interface{}) HTMLH5
+	Body(elems ...UI) HTMLH5
+	Class(v ...string) HTMLH5
+	ID(v string) HTMLH5
+	On(event string, h EventHandler, scope ...interface{}) HTMLH5
+}
+
+func H5() HTMLH5 { return nil }
+
+type HTMLH6 interface {
+	UI
+	Attr(n string, v interface{}) HTMLH6
+	Body(elems ...UI) HTMLH6
+	Class(v ...string) HTMLH6
+	ID(v string) HTMLH6
+	On(event string, h EventHandler, scope ...interface{}) HTMLH6
+}
+
+func H6() HTMLH6 { return nil }
+
+type HTMLHead interface {
+	UI
+	Attr(n string, v interface{}) HTMLHead
+	Body(elems ...UI) HTMLHead
+	Class(v ...string) HTMLHead
+	ID(v string) HTMLHead
+	On(event string, h EventHandler, scope ...interface{}) HTMLHead
+}
+
+func Head() HTMLHead { return nil }
+
+type HTMLHeader interface {
+	UI
+	Attr(n string, v interface{}) HTMLHeader
+	Body(elems ...UI) HTMLHeader
+	Class(v ...string) HTMLHeader
+	ID(v string) HTMLHeader
+	On(event string, h EventHandler, scope ...interface{}) HTMLHeader
+}
+
+func Header() HTMLHeader { return nil }
+
+type HTMLHr interface {
+	UI
+	Attr(n string, v interface{}) HTMLHr
+	Class(v ...string) HTMLHr
+	ID(v string) HTMLHr
+	On(event string, h EventHandler, scope ...interface{}) HTMLHr
+}
+
+func Hr() HTMLHr { return nil }
+
+type HTMLHtml interface {
+	UI
+	Attr(n string, v interface{}) HTMLHtml
+	Body(elems ...UI) HTMLHtml
+	Class(v ...string) HTMLHtml
+	ID(v string) HTMLHtml
+	On(event string, h EventHandler, scope ...interface{}) HTMLHtml
+}
+
+func Html() HTMLHtml { return nil }
+
+type HTMLI interface {
+	UI
+	Attr(n string, v interface{}) HTMLI
+	Body(elems ...UI) HTMLI
+	Class(v ...string) HTMLI
+	ID(v string) HTMLI
+	On(event string, h EventHandler, scope ...interface{}) HTMLI
+}
+
+func I() HTMLI { return nil }
+
+type HTMLIFrame interface {
+	UI
+	Attr(n string, v interface{}) HTMLIFrame
+	Body(elems ...UI) HTMLIFrame
+	Class(v ...string) HTMLIFrame
+	ID(v string) HTMLIFrame
+	On(event string, h EventHandler, scope ...interface{}) HTMLIFrame
+}
+
+func IFrame() HTMLIFrame { return nil }
+
+type HTMLImg interface {
+	UI
+	Attr(n string, v interface{}) HTMLImg
+	Class(v ...string) HTMLImg
+	ID(v string) HTMLImg
+	On(event string, h EventHandler, scope ...interface{}) HTMLImg
+}
+
+func Img() HTMLImg { return nil }
+
+type HTMLInput interface {
+	UI
+	Attr(n string, v interface{}) HTMLInput
+	Class(v ...string) HTMLInput
+	ID(v string) HTMLInput
+	On(event string, h EventHandler, scope ...interface{}) HTMLInput
+}
+
+func Input() HTMLInput { return nil }
+
+type HTMLIns interface {
+	UI
+	Attr(n string, v interface{}) HTMLIns
+	Body(elems ...UI) HTMLIns
+	Class(v ...string) HTMLIns
+	ID(v string) HTMLIns
+	On(event string, h EventHandler, scope ...interface{}) HTMLIns
+}
+
+func Ins() HTMLIns { return nil }
+
+type HTMLKbd interface {
+	UI
+	Attr(n string, v interface{}) HTMLKbd
+	Body(elems ...UI) HTMLKbd
+	Class(v ...string) HTMLKbd
+	ID(v string) HTMLKbd
+	On(event string, h EventHandler, scope ...interface{}) HTMLKbd
+}
+
+func Kbd() HTMLKbd { return nil }
+
+type HTMLLabel interface {
+	UI
+	Attr(n string, v interface{}) HTMLLabel
+	Body(elems ...UI) HTMLLabel
+	Class(v ...string) HTMLLabel
+	ID(v string) HTMLLabel
+	On(event string, h EventHandler, scope ...interface{}) HTMLLabel
+}
+
+func Label() HTMLLabel { return nil }
+
+type HTMLLegend interface {
+	UI
+	Attr(n string, v interface{}) HTMLLegend
+	Body(elems ...UI) HTMLLegend
+	Class(v ...string) HTMLLegend
+	ID(v string) HTMLLegend
+	On(event string, h EventHandler, scope ...interface{}) HTMLLegend
+}
+
+func Legend() HTMLLegend { return nil }
+
+type HTMLLi interface {
+	UI
+	Attr(n string, v interface{}) HTMLLi
+	Body(elems ...UI) HTMLLi
+	Class(v ...string) HTMLLi
+	ID(v string) HTMLLi
+	On(event string, h EventHandler, scope ...interface{}) HTMLLi
+}
+
+func Li() HTMLLi { return nil }
+
+type HTMLLink interface {
+	UI
+	Attr(n string, v interface{}) HTMLLink
+	Class(v ...string) HTMLLink
+	ID(v string) HTMLLink
+	On(event string, h EventHandler, scope ...interface{}) HTMLLink
+}
+
+func Link() HTMLLink { return nil }
+
+type HTMLMain interface {
+	UI
+	Attr(n string, v interface{}) HTMLMain
+	Body(elems ...UI) HTMLMain
+	Class(v ...string) HTMLMain
+	ID(v string) HTMLMain
+	On(event string, h EventHandler, scope ...interface{}) HTMLMain
+}
+
+func Main() HTMLMain { return nil }
+
+type HTMLMap interface {
+	UI
+	Attr(n string, v interface{}) HTMLMap
+	Body(elems ...UI) HTMLMap
+	Class(v ...string) HTMLMap
+	ID(v string) HTMLMap
+	On(event string, h EventHandler, scope ...interface{}) HTMLMap
+}
+
+func Map() HTMLMap { return nil }
+
+type HTMLMark interface {
+	UI
+	Attr(n string, v interface{}) HTMLMark
+	Body(elems ...UI) HTMLMark
+	Class(v ...string) HTMLMark
+	ID(v string) HTMLMark
+	On(event string, h EventHandler, scope ...interface{}) HTMLMark
+}
+
+func Mark() HTMLMark { return nil }
+
+type HTMLMeta interface {
+	UI
+	Attr(n string, v interface{}) HTMLMeta
+	Class(v ...string) HTMLMeta
+	ID(v string) HTMLMeta
+	On(event string, h EventHandler, scope ...interface{}) HTMLMeta
+}
+
+func Meta() HTMLMeta { return nil }
+
+type HTMLMeter interface {
+	UI
+	Attr(n string, v interface{}) HTMLMeter
+	Body(elems ...UI) HTMLMeter
+	Class(v ...string) HTMLMeter
+	ID(v string) HTMLMeter
+	On(event string, h EventHandler, scope ...interface{}) HTMLMeter
+}
+
+func Meter() HTMLMeter { return nil }
+
+type HTMLNav interface {
+	UI
+	Attr(n string, v interface{}) HTMLNav
+	Body(elems ...UI) HTMLNav
+	Class(v ...string) HTMLNav
+	ID(v string) HTMLNav
+	On(event string, h EventHandler, scope ...interface{}) HTMLNav
+}
+
+func Nav() HTMLNav { return nil }
+
+type HTMLNoScript interface {
+	UI
+	Attr(n string, v interface{}) HTMLNoScript
+	Body(elems ...UI) HTMLNoScript
+	Class(v ...string) HTMLNoScript
+	ID(v string) HTMLNoScript
+	On(event string, h EventHandler, scope ...interface{}) HTMLNoScript
+}
+
+func NoScript() HTMLNoScript { return nil }
+
+type HTMLObject interface {
+	UI
+	Attr(n string, v interface{}) HTMLObject
+	Body(elems ...UI) HTMLObject
+	Class(v ...string) HTMLObject
+	ID(v string) HTMLObject
+	On(event string, h EventHandler, scope ...interface{}) HTMLObject
+}
+
+func Object() HTMLObject { return nil }
+
+type HTMLOl interface {
+	UI
+	Attr(n string, v interface{}) HTMLOl
+	Body(elems ...UI) HTMLOl
+	Class(v ...string) HTMLOl
+	ID(v string) HTMLOl
+	On(event string, h EventHandler, scope ...interface{}) HTMLOl
+}
+
+func Ol() HTMLOl { return nil }
+
+type HTMLOptGroup interface {
+	UI
+	Attr(n string, v interface{}) HTMLOptGroup
+	Body(elems ...UI) HTMLOptGroup
+	Class(v ...string) HTMLOptGroup
+	ID(v string) HTMLOptGroup
+	On(event string, h EventHandler, scope ...interface{}) HTMLOptGroup
+}
+
+func OptGroup() HTMLOptGroup { return nil }
+
+type HTMLOption interface {
+	UI
+	Attr(n string, v interface{}) HTMLOption
+	Body(elems ...UI) HTMLOption
+	Class(v ...string) HTMLOption
+	ID(v string) HTMLOption
+	On(event string, h EventHandler, scope ...interface{}) HTMLOption
+}
+
+func Option() HTMLOption { return nil }
+
+type HTMLOutput interface {
+	UI
+	Attr(n string, v interface{}) HTMLOutput
+	Body(elems ...UI) HTMLOutput
+	Class(v ...string) HTMLOutput
+	ID(v string) HTMLOutput
+	On(event string, h EventHandler, scope ...interface{}) HTMLOutput
+}
+
+func Output() HTMLOutput { return nil }
+
+type HTMLP interface {
+	UI
+	Attr(n string, v interface{}) HTMLP
+	Body(elems ...UI) HTMLP
+	Class(v ...string) HTMLP
+	ID(v string) HTMLP
+	On(event string, h EventHandler, scope ...interface{}) HTMLP
+}
+
+func P() HTMLP { return nil }
+
+type HTMLParam interface {
+	UI
+	Attr(n string, v interface{}) HTMLParam
+	Class(v ...string) HTMLParam
+	ID(v string) HTMLParam
+	On(event string, h EventHandler, scope ...interface{}) HTMLParam
+}
+
+func Param() HTMLParam { return nil }
+
+type HTMLPicture interface {
+	UI
+	Attr(n string, v interface{}) HTMLPicture
+	Body(elems ...UI) HTMLPicture
+	Class(v ...string) HTMLPicture
+	ID(v string) HTMLPicture
+	On(event string, h EventHandler, scope ...interface{}) HTMLPicture
+}
+
+func Picture() HTMLPicture { return nil }
+
+type HTMLPre interface {
+	UI
+	Attr(n string, v interface{}) HTMLPre
+	Body(elems ...UI) HTMLPre
+	Class(v ...string) HTMLPre
+	ID(v string) HTMLPre
+	On(event string, h EventHandler, scope ...interface{}) HTMLPre
+}
+
+func Pre() HTMLPre { return nil }
+
+type HTMLProgress interface {
+	UI
+	Attr(n string, v interface{}) HTMLProgress
+	Body(elems ...UI) HTMLProgress
+	Class(v ...string) HTMLProgress
+	ID(v string) HTMLProgress
+	On(event string, h EventHandler, scope ...interface{}) HTMLProgress
+}
+
+func Progress() HTMLProgress { return nil }
+
+type HTMLQ interface {
+	UI
+	Attr(n string, v interface{}) HTMLQ
+	Body(elems ...UI) HTMLQ
+	Class(v ...string) HTMLQ
+	ID(v string) HTMLQ
+	On(event string, h EventHandler, scope ...interface{}) HTMLQ
+}
+
+func Q() HTMLQ { return nil }
+
+type HTMLRp interface {
+	UI
+	Attr(n string, v interface{}) HTMLRp
+	Body(elems ...UI) HTMLRp
+	Class(v ...string) HTMLRp
+	ID(v string) HTMLRp
+	On(event string, h EventHandler, scope ...interface{}) HTMLRp
+}
+
+func Rp() HTMLRp { return nil }
+
+type HTMLRt interface {
+	UI
+	Attr(n string, v interface{}) HTMLRt
+	Body(elems ...UI) HTMLRt
+	Class(v ...string) HTMLRt
+	ID(v string) HTMLRt
+	On(event string, h EventHandler, scope ...interface{}) HTMLRt
+}
+
+func Rt() HTMLRt { return nil }
+
+type HTMLRuby interface {
+	UI
+	Attr(n string, v interface{}) HTMLRuby
+	Body(elems ...UI) HTMLRuby
+	Class(v ...string) HTMLRuby
+	ID(v string) HTMLRuby
+	On(event string, h EventHandler, scope ...interface{}) HTMLRuby
+}
+
+func Ruby() HTMLRuby { return nil }
+
+type HTMLS interface {
+	UI
+	Attr(n string, v interface{}) HTMLS
+	Body(elems ...UI) HTMLS
+	Class(v ...string) HTMLS
+	ID(v string) HTMLS
+	On(event string, h EventHandler, scope ...interface{}) HTMLS
+}
+
+func S() HTMLS { return nil }
+
+type HTMLSamp interface {
+	UI
+	Attr(n string, v interface{}) HTMLSamp
+	Body(elems ...UI) HTMLSamp
+	Class(v ...string) HTMLSamp
+	ID(v string) HTMLSamp
+	On(event string, h EventHandler, scope ...interface{}) HTMLSamp
+}
+
+func Samp() HTMLSamp { return nil }
+
+type HTMLScript interface {
+	UI
+	Attr(n string, v interface{}) HTMLScript
+	Body(elems ...UI) HTMLScript
+	Class(v ...string) HTMLScript
+	ID(v string) HTMLScript
+	On(event string, h EventHandler, scope ...interface{}) HTMLScript
+}
+
+func Script() HTMLScript { return nil }
+
+type HTMLSection interface {
+	UI
+	Attr(n string, v interface{}) HTMLSection
+	Body(elems ...UI) HTMLSection
+	Class(v ...string) HTMLSection
+	ID(v string) HTMLSection
+	On(event string, h EventHandler, scope ...interface{}) HTMLSection
+}
+
+func Section() HTMLSection { return nil }
+
+type HTMLSelect interface {
+	UI
+	Attr(n string, v interface{}) HTMLSelect
+	Body(elems ...UI) HTMLSelect
+	Class(v ...string) HTMLSelect
+	ID(v string) HTMLSelect
+	On(event string, h EventHandler, scope ...interface{}) HTMLSelect
+}
+
+func Select() HTMLSelect { return nil }
+
+type HTMLSmall interface {
+	UI
+	Attr(n string, v interface{}) HTMLSmall
+	Body(elems ...UI) HTMLSmall
+	Class(v ...string) HTMLSmall
+	ID(v string) HTMLSmall
+	On(event string, h EventHandler, scope ...interface{}) HTMLSmall
+}
+
+func Small() HTMLSmall { return nil }
+
+type HTMLSource interface {
+	UI
+	Attr(n string, v interface{}) HTMLSource
+	Class(v ...string) HTMLSource
+	ID(v string) HTMLSource
+	On(event string, h EventHandler, scope ...interface{}) HTMLSource
+}
+
+func Source() HTMLSource { return nil }
+
+type HTMLSpan interface {
+	UI
+	Attr(n string, v interface{}) HTMLSpan
+	Body(elems ...UI) HTMLSpan
+	Class(v ...string) HTMLSpan
+	ID(v string) HTMLSpan
+	On(event string, h EventHandler, scope ...interface{}) HTMLSpan
+}
+
+func Span() HTMLSpan { return nil }
+
+type HTMLStrong interface {
+	UI
+	Attr(n string, v interface{}) HTMLStrong
+	Body(elems ...UI) HTMLStrong
+	Class(v ...string) HTMLStrong
+	ID(v string) HTMLStrong
+	On(event string, h EventHandler, scope ...interface{}) HTMLStrong
+}
+
+func Strong() HTMLStrong { return nil }
+
+type HTMLStyle interface {
+	UI
+	Attr(n string, v interface{}) HTMLStyle
+	Body(elems ...UI) HTMLStyle
+	Class(v ...string) HTMLStyle
+	ID(v string) HTMLStyle
+	On(event string, h EventHandler, scope ...interface{}) HTMLStyle
+}
+
+func Style() HTMLStyle { return nil }
+
+type HTMLSub interface {
+	UI
+	Attr(n string, v interface{}) HTMLSub
+	Body(elems ...UI) HTMLSub
+	Class(v ...string) HTMLSub
+	ID(v string) HTMLSub
+	On(event string, h EventHandler, scope ...interface{}) HTMLSub
+}
+
+func Sub() HTMLSub { return nil }
+
+type HTMLSummary interface {
+	UI
+	Attr(n string, v interface{}) HTMLSummary
+	Body(elems ...UI) HTMLSummary
+	Class(v ...string) HTMLSummary
+	ID(v string) HTMLSummary
+	On(event string, h EventHandler, scope ...interface{}) HTMLSummary
+}
+
+func Summary() HTMLSummary { return nil }
+
+type HTMLSup interface {
+	UI
+	Attr(n string, v interface{}) HTMLSup
+	Body(elems ...UI) HTMLSup
+	Class(v ...string) HTMLSup
+	ID(v string) HTMLSup
+	On(event string, h EventHandler, scope ...interface{}) HTMLSup
+}
+
+func Sup() HTMLSup { return nil }
+
+type HTMLTable interface {
+	UI
+	Attr(n string, v interface{}) HTMLTable
+	Body(elems ...UI) HTMLTable
+	Class(v ...string) HTMLTable
+	ID(v string) HTMLTable
+	On(event string, h EventHandler, scope ...interface{}) HTMLTable
+}
+
+func Table() HTMLTable { return nil }
+
+type HTMLTBody interface {
+	UI
+	Attr(n string, v interface{}) HTMLTBody
+	Body(elems ...UI) HTMLTBody
+	Class(v ...string) HTMLTBody
+	ID(v string) HTMLTBody
+	On(event string, h EventHandler, scope ...interface{}) HTMLTBody
+}
+
+func TBody() HTMLTBody { return nil }
+
+type HTMLTd interface {
+	UI
+	Attr(n string, v interface{}) HTMLTd
+	Body(elems ...UI) HTMLTd
+	Class(v ...string) HTMLTd
+	ID(v string) HTMLTd
+	On(event string, h EventHandler, scope ...interface{}) HTMLTd
+}
+
+func Td() HTMLTd { return nil }
+
+type HTMLTemplate interface {
+	UI
+	Attr(n string, v interface{}) HTMLTemplate
+	Body(elems ...UI) HTMLTemplate
+	Class(v ...string) HTMLTemplate
+	ID(v string) HTMLTemplate
+	On(event string, h EventHandler, scope ...interface{}) HTMLTemplate
+}
+
+func Template() HTMLTemplate { return nil }
+
+type HTMLTextarea interface {
+	UI
+	Attr(n string, v interface{}) HTMLTextarea
+	Body(elems ...UI) HTMLTextarea
+	Class(v ...string) HTMLTextarea
+	ID(v string) HTMLTextarea
+	On(event string, h EventHandler, scope ...interface{}) HTMLTextarea
+}
+
+func Textarea() HTMLTextarea { return nil }
+
+type HTMLTFoot interface {
+	UI
+	Attr(n string, v interface{}) HTMLTFoot
+	Body(elems ...UI) HTMLTFoot
+	Class(v ...string) HTMLTFoot
+	ID(v string) HTMLTFoot
+	On(event string, h EventHandler, scope ...interface{}) HTMLTFoot
+}
+
+func TFoot() HTMLTFoot { return nil }
+
+type HTMLTh interface {
+	UI
+	Attr(n string, v interface{}) HTMLTh
+	Body(elems ...UI) HTMLTh
+	Class(v ...string) HTMLTh
+	ID(v string) HTMLTh
+	On(event string, h EventHandler, scope ...interface{}) HTMLTh
+}
+
+func Th() HTMLTh { return nil }
+
+type HTMLTHead interface {
+	UI
+	Attr(n string, v interface{}) HTMLTHead
+	Body(elems ...UI) HTMLTHead
+	Class(v ...string) HTMLTHead
+	ID(v string) HTMLTHead
+	On(event string, h EventHandler, scope ...interface{}) HTMLTHead
+}
+
+func THead() HTMLTHead { return nil }
+
+type HTMLTime interface {
+	UI
+	Attr(n string, v interface{}) HTMLTime
+	Body(elems ...UI) HTMLTime
+	Class(v ...string) HTMLTime
+	ID(v string) HTMLTime
+	On(event string, h EventHandler, scope ...interface{}) HTMLTime
+}
+
+func Time() HTMLTime { return nil }
+
+type HTMLTitle interface {
+	UI
+	Attr(n string, v interface{}) HTMLTitle
+	Body(elems ...UI) HTMLTitle
+	Class(v ...string) HTMLTitle
+	ID(v string) HTMLTitle
+	On(event string, h EventHandler, scope ...interface{}) HTMLTitle
+}
+
+func Title() HTMLTitle { return nil }
+
+type HTMLTr interface {
+	UI
+	Attr(n string, v interface{}) HTMLTr
+	Body(elems ...UI) HTMLTr
+	Class(v ...string) HTMLTr
+	ID(v string) HTMLTr
+	On(event string, h EventHandler, scope ...interface{}) HTMLTr
+}
+
+func Tr() HTMLTr { return nil }
+
+type HTMLU interface {
+	UI
+	Attr(n string, v interface{}) HTMLU
+	Body(elems ...UI) HTMLU
+	Class(v ...string) HTMLU
+	ID(v string) HTMLU
+	On(event string, h EventHandler, scope ...interface{}) HTMLU
+}
+
+func U() HTMLU { return nil }
+
+type HTMLUl interface {
+	UI
+	Attr(n string, v interface{}) HTMLUl
+	Body(elems ...UI) HTMLUl
+	Class(v ...string) HTMLUl
+	ID(v string) HTMLUl
+	On(event string, h EventHandler, scope ...interface{}) HTMLUl
+}
+
+func Ul() HTMLUl { return nil }
+
+type HTMLVar interface {
+	UI
+	Attr(n string, v interface{}) HTMLVar
+	Body(elems ...UI) HTMLVar
+	Class(v ...string) HTMLVar
+	ID(v string) HTMLVar
+	On(event string, h EventHandler, scope ...interface{}) HTMLVar
+}
+
+func Var() HTMLVar { return nil }
+
+type HTMLVideo interface {
+	UI
+	Attr(n string, v interface{}) HTMLVideo
+	Body(elems ...UI) HTMLVideo
+	Class(v ...string) HTMLVideo
+	ID(v string) HTMLVideo
+	On(event string, h EventHandler, scope ...interface{}) HTMLVideo
+}
+
+func Video() HTMLVideo { return nil }
+
+type HTMLWbr interface {
+	UI
+	Attr(n string, v interface{}) HTMLWbr
+	Class(v ...string) HTMLWbr
+	ID(v string) HTMLWbr
+	On(event string, h EventHandler, scope ...interface{}) HTMLWbr
+}
+
+func Wbr() HTMLWbr { return nil }
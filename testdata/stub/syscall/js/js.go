@@ -0,0 +1,29 @@
+// Package js is a type checking stub of syscall/js.
+package js
+
+type Value struct{}
+
+func Global() Value               { return Value{} }
+func Null() Value                 { return Value{} }
+func Undefined() Value            { return Value{} }
+func ValueOf(x interface{}) Value { return Value{} }
+
+func (v Value) Get(p string) Value                       { return Value{} }
+func (v Value) Set(p string, x interface{})              {}
+func (v Value) Index(i int) Value                        { return Value{} }
+func (v Value) Call(m string, args ...interface{}) Value { return Value{} }
+func (v Value) Invoke(args ...interface{}) Value         { return Value{} }
+func (v Value) New(args ...interface{}) Value            { return Value{} }
+func (v Value) Length() int                              { return 0 }
+func (v Value) Bool() bool                               { return false }
+func (v Value) Int() int                                 { return 0 }
+func (v Value) Float() float64                           { return 0 }
+func (v Value) String() string                           { return "" }
+func (v Value) Truthy() bool                             { return false }
+
+type Func struct {
+	Value
+}
+
+func FuncOf(fn func(this Value, args []Value) interface{}) Func { return Func{} }
+func (c Func) Release()                                         {}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+)
+
+// NewTreenode ...
+func NewTreenode(d map[string]func(*vecty.Event)) *Treenode {
+	return &Treenode{
+		dispatcher: d,
+	}
+}
+
+// Treenode ...
+type Treenode struct{
+	vecty.Core
+	Node *TreeData `vecty:"prop"`
+	Depth int `vecty:"prop"`
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Treenode) Render() vecty.ComponentOrHTML {
+	return elem.ListItem(
+		vecty.Markup(
+			vecty.Class("node"),
+		),
+		elem.Span(
+			vecty.Markup(
+				event.Click(c.Toggle),
+			),
+			vecty.Text("node"),
+		),
+		func() vecty.MarkupOrChild {
+			if c.Node.Open {
+				return elem.UnorderedList(
+					func() vecty.List {
+						l := vecty.List{}
+						for _, child := range c.Node.Children {
+							child := child
+							l = append(l, &Treenode{Node: child, Depth: c.Depth + 1, dispatcher: c.dispatcher})
+						}
+						return l
+					}(),
+				)
+			}
+			return nil
+		}(),
+	)
+}
+
+// Toggle handles @click on <span>.
+func (c *Treenode) Toggle(event *vecty.Event) {
+	f, ok := c.dispatcher["Toggle"]
+	if !ok {
+		panic("unknown func: \"Toggle\"")
+	}
+	f(event)
+}
+
@@ -0,0 +1,56 @@
+//go:build js && wasm
+// +build js,wasm
+
+package main
+
+import (
+	"github.com/hexops/vecty"
+	"github.com/hexops/vecty/elem"
+	"github.com/hexops/vecty/event"
+	"github.com/hexops/vecty/prop"
+)
+
+// NewWasm ...
+func NewWasm(d map[string]func(*vecty.Event)) *Wasm {
+	return &Wasm{
+		dispatcher: d,
+	}
+}
+
+// Wasm ...
+type Wasm struct{
+	vecty.Core
+	dispatcher map[string]func(*vecty.Event)
+}
+
+// Render ...
+func (c *Wasm) Render() vecty.ComponentOrHTML {
+	return elem.Form(
+		vecty.Markup(
+			event.Submit(c.Save),
+		),
+		elem.Input(
+			vecty.Markup(
+				prop.Type("checkbox"),
+				prop.Checked(true),
+				vecty.Property("disabled", true),
+			),
+		),
+		elem.Button(
+			vecty.Markup(
+				prop.Type("submit"),
+			),
+			vecty.Text("Save"),
+		),
+	)
+}
+
+// Save handles @submit on <form>.
+func (c *Wasm) Save(event *vecty.Event) {
+	f, ok := c.dispatcher["Save"]
+	if !ok {
+		panic("unknown func: \"Save\"")
+	}
+	f(event)
+}
+